@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+)
+
+// guestNameDigits misafir görünen adındaki rastgele sayının üst sınırı
+const guestNameDigits = 1000000
+
+// NewDeviceToken misafir hesabını cihaza bağlayan rastgele bir token üretir.
+// Token yalnızca istemciye bir kez döner; sunucuda HashDeviceToken ile özeti saklanır.
+func NewDeviceToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// HashDeviceToken cihaz token'ının saklanan SHA-256 özetini döndürür. Token
+// yüksek entropili olduğu için bcrypt gerekmez ve özet doğrudan aranabilir.
+func HashDeviceToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// GuestName misafir için "Misafir-123456" biçiminde görünen ad üretir
+func GuestName() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(guestNameDigits))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Misafir-%06d", n.Int64()), nil
+}
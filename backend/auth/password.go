@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"golang.org/x/crypto/bcrypt"
+)
+
+// MaxPasswordBytes bcrypt'in kullandığı en uzun şifre (byte); daha uzun
+// şifreler özetlenemez
+const MaxPasswordBytes = 72
+
+// HashPassword şifrenin bcrypt özetini döndürür
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword şifrenin özetle eşleşip eşleşmediğini kontrol eder
+func CheckPassword(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
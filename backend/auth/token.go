@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// DefaultTokenTTL token'ların varsayılan geçerlilik süresi
+const DefaultTokenTTL = 7 * 24 * time.Hour
+
+var (
+	// ErrInvalidToken token biçimi veya imzası geçersizse döner
+	ErrInvalidToken = errors.New("geçersiz oturum token'ı")
+	// ErrTokenExpired token'ın süresi dolduysa döner
+	ErrTokenExpired = errors.New("oturum token'ının süresi doldu")
+)
+
+// tokenHeader HS256 JWT başlığı (tüm token'larda aynı)
+var tokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// Claims token'da taşınan bilgiler
+type Claims struct {
+	Subject   string `json:"sub"` // Kullanıcı ID'si
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// Signer HMAC-SHA256 ile imzalanmış JWT'ler üretir ve doğrular
+type Signer struct {
+	secret []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewSigner verilen gizli anahtar ve geçerlilik süresiyle Signer oluşturur
+func NewSigner(secret []byte, ttl time.Duration) *Signer {
+	if ttl <= 0 {
+		ttl = DefaultTokenTTL
+	}
+	return &Signer{secret: secret, ttl: ttl, now: time.Now}
+}
+
+// Issue kullanıcı için yeni bir token ve bitiş zamanını döndürür
+func (s *Signer) Issue(userID string) (string, time.Time, error) {
+	now := s.now()
+	expiresAt := now.Add(s.ttl)
+	payload, err := json.Marshal(Claims{
+		Subject:   userID,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	unsigned := tokenHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + s.sign(unsigned), expiresAt, nil
+}
+
+// Parse token'ın imzasını ve süresini doğrulayıp içeriğini döndürür
+func (s *Signer) Parse(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != tokenHeader {
+		return nil, ErrInvalidToken
+	}
+
+	expected := s.sign(parts[0] + "." + parts[1])
+	if !hmac.Equal([]byte(expected), []byte(parts[2])) {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Subject == "" {
+		return nil, ErrInvalidToken
+	}
+	if s.now().Unix() >= claims.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+	return &claims, nil
+}
+
+// sign imzasız token için base64url kodlu HMAC-SHA256 imzasını döndürür
+func (s *Signer) sign(unsigned string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -83,47 +83,6 @@ func GetCollection(collectionName string) *mongo.Collection {
 	return DB.Collection(collectionName)
 }
 
-// InitializeCollections gerekli koleksiyonları ve indekslerini oluşturur
-func InitializeCollections() {
-	ctx := context.Background()
-
-	// PlayerStates koleksiyonu için indeksler
-	playerStatesCollection := GetCollection("player_states")
-	
-	// UserID için unique indeks
-	userIDIndex := mongo.IndexModel{
-		Keys:    map[string]int{"userId": 1},
-		Options: options.Index().SetUnique(true),
-	}
-	
-	_, err := playerStatesCollection.Indexes().CreateOne(ctx, userIDIndex)
-	if err != nil {
-		log.Printf("⚠️ PlayerStates indeks oluşturma hatası: %v", err)
-	} else {
-		log.Println("✅ PlayerStates koleksiyonu indeksleri oluşturuldu")
-	}
-
-	// Highscores koleksiyonu için indeksler
-	highscoresCollection := GetCollection("highscores")
-	
-	// Score için azalan sıralama indeksi (leaderboard için)
-	scoreIndex := mongo.IndexModel{
-		Keys: map[string]int{"score": -1},
-	}
-	
-	// DateAchieved için azalan sıralama indeksi
-	dateIndex := mongo.IndexModel{
-		Keys: map[string]int{"dateAchieved": -1},
-	}
-	
-	_, err = highscoresCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{scoreIndex, dateIndex})
-	if err != nil {
-		log.Printf("⚠️ Highscores indeks oluşturma hatası: %v", err)
-	} else {
-		log.Println("✅ Highscores koleksiyonu indeksleri oluşturuldu")
-	}
-}
-
 // HealthCheck veritabanı sağlık durumunu kontrol eder
 func HealthCheck() error {
 	if Client == nil {
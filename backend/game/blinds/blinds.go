@@ -0,0 +1,197 @@
+package blinds
+
+import (
+	"fmt"
+	"math"
+
+	"balatro-backend/game/cards"
+	"balatro-backend/game/rng"
+	"balatro-backend/game/stakes"
+	"balatro-backend/models"
+)
+
+// Kind blind türü
+type Kind string
+
+// Blind türleri (her ante'de sırasıyla oynanır)
+const (
+	Small Kind = "SMALL"
+	Big   Kind = "BIG"
+	Boss  Kind = "BOSS"
+)
+
+// Order bir ante içindeki blind sırası
+var Order = []Kind{Small, Big, Boss}
+
+// anteBaseTargets ante başına small blind hedefi (ante 1'den başlar)
+var anteBaseTargets = []int64{100, 250, 500, 1000, 2000, 3500, 6000, 10000}
+
+// lateAnteGrowth tablonun ötesindeki her ante için hedef çarpanı
+const lateAnteGrowth = 1.75
+
+// kindMultipliers blind türüne göre hedef çarpanı
+var kindMultipliers = map[Kind]float64{
+	Small: 1,
+	Big:   1.5,
+	Boss:  2,
+}
+
+// kindRewards blind türüne göre tamamlama ödülü
+var kindRewards = map[Kind]int{
+	Small: 3,
+	Big:   4,
+	Boss:  5,
+}
+
+// BossBlind boss blind'ın kuralları
+type BossBlind struct {
+	ID                  string  `json:"id"`
+	Name                string  `json:"name"`
+	Description         string  `json:"description"`
+	DebuffSuit          string  `json:"debuffSuit,omitempty"`          // Bu türdeki kartlar puan vermez
+	FirstHandScoresZero bool    `json:"firstHandScoresZero,omitempty"` // İlk el sıfır puan verir
+	MaxHands            int     `json:"maxHands,omitempty"`            // 0 ise sınır yok
+	NoDiscards          bool    `json:"noDiscards,omitempty"`          // Discard hakkı verilmez
+	TargetMultiplier    float64 `json:"targetMultiplier"`              // Hedef çarpanı (varsayılan 2)
+}
+
+// bossTable tüm boss blind'lar (seçim sırası seed'e bağlıdır)
+var bossTable = []BossBlind{
+	{ID: "the_club", Name: "The Club", Description: "Tüm Sinek kartlar debuff edilir", DebuffSuit: cards.Clubs, TargetMultiplier: 2},
+	{ID: "the_goad", Name: "The Goad", Description: "Tüm Maça kartlar debuff edilir", DebuffSuit: cards.Spades, TargetMultiplier: 2},
+	{ID: "the_head", Name: "The Head", Description: "Tüm Kupa kartlar debuff edilir", DebuffSuit: cards.Hearts, TargetMultiplier: 2},
+	{ID: "the_window", Name: "The Window", Description: "Tüm Karo kartlar debuff edilir", DebuffSuit: cards.Diamonds, TargetMultiplier: 2},
+	{ID: "the_hush", Name: "The Hush", Description: "Oynanan ilk el sıfır puan verir", FirstHandScoresZero: true, TargetMultiplier: 2},
+	{ID: "the_needle", Name: "The Needle", Description: "Yalnızca 1 el oynanabilir", MaxHands: 1, TargetMultiplier: 1},
+	{ID: "the_water", Name: "The Water", Description: "Discard hakkı yok", NoDiscards: true, TargetMultiplier: 2},
+	{ID: "the_wall", Name: "The Wall", Description: "Çok büyük blind", TargetMultiplier: 4},
+}
+
+// Blind bir ante içindeki tek blind'ın bilgileri
+type Blind struct {
+	Ante   int        `json:"ante"`
+	Kind   Kind       `json:"kind"`
+	Target int64      `json:"target"`
+	Reward int        `json:"reward"`
+	Boss   *BossBlind `json:"boss,omitempty"`
+}
+
+// IsValidKind blind türünün geçerli olup olmadığını kontrol eder
+func IsValidKind(kind Kind) bool {
+	_, ok := kindMultipliers[kind]
+	return ok
+}
+
+// BaseTarget ante'nin small blind hedefini döndürür
+func BaseTarget(ante int) int64 {
+	if ante < 1 {
+		ante = 1
+	}
+	if ante <= len(anteBaseTargets) {
+		return anteBaseTargets[ante-1]
+	}
+	last := float64(anteBaseTargets[len(anteBaseTargets)-1])
+	extra := ante - len(anteBaseTargets)
+	return int64(math.Round(last * math.Pow(lateAnteGrowth, float64(extra))))
+}
+
+// Target blind'ın hedef skorunu döndürür; boss blind'lar kendi çarpanını kullanır,
+// aktif stake hedefi ayrıca büyütebilir
+func Target(ante int, kind Kind, boss *BossBlind, stake stakes.Modifiers) int64 {
+	multiplier := kindMultipliers[kind]
+	if kind == Boss && boss != nil {
+		multiplier = boss.TargetMultiplier
+	}
+	if stake.TargetMultiplier > 0 {
+		multiplier *= stake.TargetMultiplier
+	}
+	return int64(math.Round(float64(BaseTarget(ante)) * multiplier))
+}
+
+// MinimumTarget blind'ın olabilecek en düşük hedefini döndürür: stake
+// uygulanmaz ve boss blind'larda en düşük çarpanlı boss varsayılır
+func MinimumTarget(ante int, kind Kind) int64 {
+	if kind != Boss {
+		return Target(ante, kind, nil, stakes.Modifiers{})
+	}
+	easiest := bossTable[0]
+	for _, boss := range bossTable[1:] {
+		if boss.TargetMultiplier < easiest.TargetMultiplier {
+			easiest = boss
+		}
+	}
+	return Target(ante, kind, &easiest, stakes.Modifiers{})
+}
+
+// Reward blind tamamlama ödülünü aktif stake'e göre döndürür
+func Reward(kind Kind, stake stakes.Modifiers) int {
+	if kind == Small && stake.NoSmallBlindReward {
+		return 0
+	}
+	return kindRewards[kind]
+}
+
+// BossForAnte seed'e göre ante'nin boss blind'ını seçer
+func BossForAnte(seed string, ante int) *BossBlind {
+	r := rng.New(seed, fmt.Sprintf("boss:%d", ante))
+	boss := bossTable[r.Intn(len(bossTable))]
+	return &boss
+}
+
+// GetBoss ID ile boss blind'ı döndürür
+func GetBoss(id string) (*BossBlind, bool) {
+	for _, boss := range bossTable {
+		if boss.ID == id {
+			b := boss
+			return &b, true
+		}
+	}
+	return nil, false
+}
+
+// ForAnte ante'deki üç blind'ı döndürür; seed boşsa boss modifikasyonu uygulanmaz
+func ForAnte(seed string, ante int, stake stakes.Modifiers) []Blind {
+	var boss *BossBlind
+	if seed != "" {
+		boss = BossForAnte(seed, ante)
+	}
+
+	result := make([]Blind, 0, len(Order))
+	for _, kind := range Order {
+		blind := Blind{
+			Ante:   ante,
+			Kind:   kind,
+			Target: Target(ante, kind, boss, stake),
+			Reward: Reward(kind, stake),
+		}
+		if kind == Boss {
+			blind.Boss = boss
+		}
+		result = append(result, blind)
+	}
+	return result
+}
+
+// Next ante ve blind türünden sonraki blind'ı döndürür
+func Next(ante int, kind Kind) (int, Kind) {
+	for i, k := range Order {
+		if k == kind && i+1 < len(Order) {
+			return ante, Order[i+1]
+		}
+	}
+	return ante + 1, Small
+}
+
+// Cleared verilen skorun blind'ı geçip geçmediğini kontrol eder
+func Cleared(ante int, kind Kind, boss *BossBlind, stake stakes.Modifiers, score int64) bool {
+	return score >= Target(ante, kind, boss, stake)
+}
+
+// IsDebuffed kartın boss blind tarafından debuff edilip edilmediğini kontrol eder
+func (b *BossBlind) IsDebuffed(card models.Card) bool {
+	if b == nil || b.DebuffSuit == "" || cards.IsStone(card) {
+		return false
+	}
+	// Wild kartlar tüm türleri saydığı için onlar da debuff edilir
+	return card.Suit == b.DebuffSuit || cards.IsWild(card)
+}
@@ -0,0 +1,138 @@
+package cards
+
+import (
+	"balatro-backend/models"
+)
+
+// Kart türleri (frontend Card.js SUITS ile aynı)
+const (
+	Spades   = "SPADES"   // Maça
+	Hearts   = "HEARTS"   // Kupa
+	Diamonds = "DIAMONDS" // Karo
+	Clubs    = "CLUBS"    // Sinek
+)
+
+// Kart değerleri (frontend Card.js VALUES ile aynı)
+const (
+	Two   = "2"
+	Three = "3"
+	Four  = "4"
+	Five  = "5"
+	Six   = "6"
+	Seven = "7"
+	Eight = "8"
+	Nine  = "9"
+	Ten   = "10"
+	Jack  = "JACK"
+	Queen = "QUEEN"
+	King  = "KING"
+	Ace   = "ACE"
+)
+
+// Enhancement tipleri (frontend Card.js ENHANCEMENTS ile aynı)
+const (
+	Wild        models.Enhancement = "WILD"         // Herhangi bir türe dönüşebilir
+	Glass       models.Enhancement = "GLASS"        // x2 çarpan, kırılma riski
+	Steel       models.Enhancement = "STEEL"        // Elde tutulduğunda çarpan
+	Gold        models.Enhancement = "GOLD"         // Ekstra para
+	Stone       models.Enhancement = "STONE"        // Ekstra çip, değeri ve türü yok
+	BonusChip1  models.Enhancement = "BONUS_CHIP_1" // +1 Çip bonusu
+	BonusChip2  models.Enhancement = "BONUS_CHIP_2" // +2 Çip bonusu
+	BonusChip4  models.Enhancement = "BONUS_CHIP_4" // +4 Çip bonusu
+	Multiplier1 models.Enhancement = "MULTIPLIER_1" // +1 Çarpan bonusu
+	Multiplier2 models.Enhancement = "MULTIPLIER_2" // +2 Çarpan bonusu
+)
+
+// Suits tüm kart türleri (deste oluşturma sırası)
+var Suits = []string{Spades, Hearts, Diamonds, Clubs}
+
+// Values tüm kart değerleri (küçükten büyüğe)
+var Values = []string{Two, Three, Four, Five, Six, Seven, Eight, Nine, Ten, Jack, Queen, King, Ace}
+
+// numericValues poker karşılaştırması için sayısal değerler
+var numericValues = map[string]int{
+	Two: 2, Three: 3, Four: 4, Five: 5, Six: 6, Seven: 7, Eight: 8, Nine: 9,
+	Ten: 10, Jack: 11, Queen: 12, King: 13, Ace: 14,
+}
+
+// chipValues kartların temel çip değerleri
+var chipValues = map[string]int{
+	Two: 2, Three: 3, Four: 4, Five: 5, Six: 6, Seven: 7, Eight: 8, Nine: 9,
+	Ten: 10, Jack: 10, Queen: 10, King: 10, Ace: 11,
+}
+
+// NumericValue kartın sayısal değerini döndürür (bilinmeyen değer için 0)
+func NumericValue(value string) int {
+	return numericValues[value]
+}
+
+// ChipValue kartın temel çip değerini döndürür (bilinmeyen değer için 0)
+func ChipValue(value string) int {
+	return chipValues[value]
+}
+
+// IsValidSuit türün geçerli olup olmadığını kontrol eder
+func IsValidSuit(suit string) bool {
+	for _, s := range Suits {
+		if s == suit {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidValue değerin geçerli olup olmadığını kontrol eder
+func IsValidValue(value string) bool {
+	_, ok := numericValues[value]
+	return ok
+}
+
+// HasEnhancement kartın belirli bir enhancement'a sahip olup olmadığını kontrol eder
+func HasEnhancement(card models.Card, enhancement models.Enhancement) bool {
+	for _, e := range card.Enhancements {
+		if e == enhancement {
+			return true
+		}
+	}
+	return false
+}
+
+// IsWild kart Wild mı (tüm türler yerine geçer)
+func IsWild(card models.Card) bool {
+	return HasEnhancement(card, Wild)
+}
+
+// IsStone kart Stone mu (değeri ve türü yok sayılır)
+func IsStone(card models.Card) bool {
+	return HasEnhancement(card, Stone)
+}
+
+// IsFace kart resimli mi (Vale, Kız, Papaz)
+func IsFace(card models.Card) bool {
+	return card.Value == Jack || card.Value == Queen || card.Value == King
+}
+
+// NextValue kartın bir üst değerini döndürür (ACE'den sonra 2'ye döner)
+func NextValue(value string) (string, bool) {
+	for i, v := range Values {
+		if v == value {
+			return Values[(i+1)%len(Values)], true
+		}
+	}
+	return "", false
+}
+
+// NewStandardDeck 52'lik standart poker destesi oluşturur
+func NewStandardDeck() []models.Card {
+	deck := make([]models.Card, 0, len(Suits)*len(Values))
+	for _, suit := range Suits {
+		for _, value := range Values {
+			deck = append(deck, models.Card{
+				Suit:         suit,
+				Value:        value,
+				Enhancements: []models.Enhancement{},
+			})
+		}
+	}
+	return deck
+}
@@ -0,0 +1,184 @@
+package cards
+
+import (
+	"errors"
+	"fmt"
+
+	"balatro-backend/models"
+)
+
+// ErrInvalidCard kart alanlarından biri geçersizse döner
+var ErrInvalidCard = errors.New("geçersiz kart")
+
+// Edition tipleri: kart puana katıldığında uygulanır
+const (
+	Foil        models.Edition = "FOIL"        // +50 çip
+	Holographic models.Edition = "HOLOGRAPHIC" // +10 çarpan
+	Polychrome  models.Edition = "POLYCHROME"  // x1.5 çarpan
+)
+
+// Seal tipleri
+const (
+	GoldSeal   models.Seal = "GOLD"   // Kart puana katıldığında $3
+	RedSeal    models.Seal = "RED"    // Kart bir kez daha tetiklenir
+	BlueSeal   models.Seal = "BLUE"   // Blind sonunda elde tutulursa son oynanan elin planet'i kullanılır
+	PurpleSeal models.Seal = "PURPLE" // Discard edildiğinde rastgele bir tarot verir
+)
+
+// Kart efekti sabitleri
+const (
+	GoldSealMoney    = 3 // Gold seal'lı kart puana katıldığında kazanılan para
+	GoldHeldMoney    = 3 // Gold enhancement'lı kart blind sonunda eldeyse kazanılan para
+	GlassShatterOdds = 4 // Glass kart puana katıldıktan sonra 1/4 olasılıkla kırılır
+	SteelHeldMult    = 1 // Steel kart elde tutulduğunda eklenen çarpan
+)
+
+// Enhancements tüm enhancement tipleri
+var Enhancements = []models.Enhancement{
+	Wild, Glass, Steel, Gold, Stone,
+	BonusChip1, BonusChip2, BonusChip4,
+	Multiplier1, Multiplier2,
+}
+
+// Editions tüm edition tipleri
+var Editions = []models.Edition{Foil, Holographic, Polychrome}
+
+// Seals tüm seal tipleri
+var Seals = []models.Seal{GoldSeal, RedSeal, BlueSeal, PurpleSeal}
+
+// Bonus kartın puana eklediği çip, çarpan ve çarpım
+type Bonus struct {
+	Chips int
+	Mult  float64
+	XMult float64
+}
+
+// enhancementGroups aynı gruptaki enhancement'lar bir kartta birlikte bulunamaz
+var enhancementGroups = map[models.Enhancement]string{
+	Wild: "material", Glass: "material", Steel: "material", Gold: "material", Stone: "material",
+	BonusChip1: "chips", BonusChip2: "chips", BonusChip4: "chips",
+	Multiplier1: "mult", Multiplier2: "mult",
+}
+
+// enhancementBonuses kart puana katıldığında enhancement bonusları
+var enhancementBonuses = map[models.Enhancement]Bonus{
+	BonusChip1:  {Chips: 1},
+	BonusChip2:  {Chips: 2},
+	BonusChip4:  {Chips: 4},
+	Stone:       {Chips: 50},
+	Multiplier1: {Mult: 1},
+	Multiplier2: {Mult: 2},
+	Glass:       {XMult: 2},
+}
+
+// editionBonuses kart puana katıldığında edition bonusları
+var editionBonuses = map[models.Edition]Bonus{
+	Foil:        {Chips: 50},
+	Holographic: {Mult: 10},
+	Polychrome:  {XMult: 1.5},
+}
+
+// IsValidEnhancement enhancement tipinin tanımlı olup olmadığını kontrol eder
+func IsValidEnhancement(e models.Enhancement) bool {
+	_, ok := enhancementGroups[e]
+	return ok
+}
+
+// IsValidEdition edition tipinin tanımlı olup olmadığını kontrol eder (boş = edition yok)
+func IsValidEdition(e models.Edition) bool {
+	if e == "" {
+		return true
+	}
+	_, ok := editionBonuses[e]
+	return ok
+}
+
+// IsValidSeal seal tipinin tanımlı olup olmadığını kontrol eder (boş = seal yok)
+func IsValidSeal(s models.Seal) bool {
+	if s == "" {
+		return true
+	}
+	for _, seal := range Seals {
+		if seal == s {
+			return true
+		}
+	}
+	return false
+}
+
+// EnhancementBonus enhancement'ın puana katılan karta verdiği bonusu döndürür
+func EnhancementBonus(e models.Enhancement) (Bonus, bool) {
+	bonus, ok := enhancementBonuses[e]
+	return bonus, ok
+}
+
+// EditionBonus edition'ın puana katılan karta verdiği bonusu döndürür
+func EditionBonus(e models.Edition) (Bonus, bool) {
+	bonus, ok := editionBonuses[e]
+	return bonus, ok
+}
+
+// Retriggers kartın puana katıldığında kaç kez ek tetikleneceğini döndürür
+func Retriggers(card models.Card) int {
+	if card.Seal == RedSeal {
+		return 1
+	}
+	return 0
+}
+
+// WithEnhancement karta enhancement ekler; aynı gruptaki mevcut enhancement'ın
+// yerini alır. Kart zaten bu enhancement'a sahipse false döner.
+func WithEnhancement(card models.Card, e models.Enhancement) (models.Card, bool) {
+	if HasEnhancement(card, e) {
+		return card, false
+	}
+	result := []models.Enhancement{}
+	for _, existing := range card.Enhancements {
+		if enhancementGroups[existing] != enhancementGroups[e] {
+			result = append(result, existing)
+		}
+	}
+	card.Enhancements = append(result, e)
+	return card, true
+}
+
+// Validate kartın türünü, değerini, enhancement'larını, edition'ını ve seal'ını doğrular.
+// Enhancement'lar tekrarlanamaz ve aynı gruptan (materyal, çip, çarpan) birden fazla olamaz.
+func Validate(card models.Card) error {
+	if !IsValidSuit(card.Suit) {
+		return fmt.Errorf("%w: bilinmeyen tür %q", ErrInvalidCard, card.Suit)
+	}
+	if !IsValidValue(card.Value) {
+		return fmt.Errorf("%w: bilinmeyen değer %q", ErrInvalidCard, card.Value)
+	}
+
+	groups := map[string]bool{}
+	for _, e := range card.Enhancements {
+		group, ok := enhancementGroups[e]
+		if !ok {
+			return fmt.Errorf("%w: bilinmeyen enhancement %q", ErrInvalidCard, e)
+		}
+		if groups[group] {
+			return fmt.Errorf("%w: %q ile aynı gruptan birden fazla enhancement", ErrInvalidCard, e)
+		}
+		groups[group] = true
+	}
+
+	if !IsValidEdition(card.Edition) {
+		return fmt.Errorf("%w: bilinmeyen edition %q", ErrInvalidCard, card.Edition)
+	}
+	if !IsValidSeal(card.Seal) {
+		return fmt.Errorf("%w: bilinmeyen seal %q", ErrInvalidCard, card.Seal)
+	}
+	return nil
+}
+
+// ValidateAll kart listesindeki tüm kartları doğrular
+func ValidateAll(list []models.Card) error {
+	for i, card := range list {
+		if err := Validate(card); err != nil {
+			return fmt.Errorf("kart %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package decks
+
+import (
+	"errors"
+	"sort"
+
+	"balatro-backend/game/cards"
+	"balatro-backend/models"
+)
+
+// ErrUnknownDeck deste ID'si tanımlı değilse döner
+var ErrUnknownDeck = errors.New("bilinmeyen deste")
+
+// Deste ID'leri
+const (
+	Red       = "red"
+	Blue      = "blue"
+	Yellow    = "yellow"
+	Abandoned = "abandoned"
+	Checkered = "checkered"
+)
+
+// Default deste seçilmezse kullanılan deste
+const Default = Red
+
+// Definition başlangıç destesi tanımı; değerler run başlangıç kurallarına eklenir
+type Definition struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	ExtraMoney    int    `json:"extraMoney,omitempty"`    // Başlangıç parasına eklenir
+	ExtraHands    int    `json:"extraHands,omitempty"`    // Her blind'daki el hakkına eklenir
+	ExtraDiscards int    `json:"extraDiscards,omitempty"` // Her blind'daki discard hakkına eklenir
+
+	cards func() []models.Card
+}
+
+// catalog tüm desteler
+var catalog = map[string]*Definition{
+	Red: {
+		ID:            Red,
+		Name:          "Red Deck",
+		Description:   "Her blind'da +1 discard hakkı",
+		ExtraDiscards: 1,
+		cards:         cards.NewStandardDeck,
+	},
+	Blue: {
+		ID:          Blue,
+		Name:        "Blue Deck",
+		Description: "Her blind'da +1 el hakkı",
+		ExtraHands:  1,
+		cards:       cards.NewStandardDeck,
+	},
+	Yellow: {
+		ID:          Yellow,
+		Name:        "Yellow Deck",
+		Description: "Başlangıçta +$10",
+		ExtraMoney:  10,
+		cards:       cards.NewStandardDeck,
+	},
+	Abandoned: {
+		ID:          Abandoned,
+		Name:        "Abandoned Deck",
+		Description: "Destede resimli kart yok (40 kart)",
+		cards: func() []models.Card {
+			return filter(cards.NewStandardDeck(), func(card models.Card) bool { return !cards.IsFace(card) })
+		},
+	},
+	Checkered: {
+		ID:          Checkered,
+		Name:        "Checkered Deck",
+		Description: "26 Maça ve 26 Kupa ile başlar",
+		cards: func() []models.Card {
+			var deck []models.Card
+			for _, suit := range []string{cards.Spades, cards.Hearts} {
+				for i := 0; i < 2; i++ {
+					for _, value := range cards.Values {
+						deck = append(deck, models.Card{Suit: suit, Value: value, Enhancements: []models.Enhancement{}})
+					}
+				}
+			}
+			return deck
+		},
+	},
+}
+
+// Get ID ile deste tanımını döndürür; boş ID varsayılan desteyi döndürür
+func Get(id string) (*Definition, bool) {
+	if id == "" {
+		id = Default
+	}
+	def, ok := catalog[id]
+	return def, ok
+}
+
+// IsKnown deste ID'sinin tanımlı olup olmadığını kontrol eder
+func IsKnown(id string) bool {
+	_, ok := catalog[id]
+	return ok
+}
+
+// All tüm deste tanımlarını ID sırasıyla döndürür
+func All() []*Definition {
+	result := make([]*Definition, 0, len(catalog))
+	for _, def := range catalog {
+		result = append(result, def)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+// MaxCards en kalabalık destenin kart sayısını döndürür. Oyunda desteye kart
+// eklenmediği için deste ve eldeki kartların toplamı bunu aşamaz.
+func MaxCards() int {
+	max := 0
+	for _, def := range catalog {
+		if n := len(def.cards()); n > max {
+			max = n
+		}
+	}
+	return max
+}
+
+// MaxCopies bir kartın destede bulunabilecek en fazla aynı kopya sayısını
+// döndürür. Fool tarot'u türü değiştirebildiği için bir değerin tüm kartları
+// aynı karta dönüşebilir; sınır bu yüzden en kalabalık destede aynı değerli
+// kart sayısıdır.
+func MaxCopies() int {
+	max := 0
+	for _, def := range catalog {
+		counts := map[string]int{}
+		for _, card := range def.cards() {
+			counts[card.Value]++
+			if counts[card.Value] > max {
+				max = counts[card.Value]
+			}
+		}
+	}
+	return max
+}
+
+// Cards destenin başlangıç kartlarını döndürür
+func (d *Definition) Cards() []models.Card {
+	return d.cards()
+}
+
+// filter koşulu sağlayan kartları döndürür
+func filter(deck []models.Card, keep func(models.Card) bool) []models.Card {
+	result := []models.Card{}
+	for _, card := range deck {
+		if keep(card) {
+			result = append(result, card)
+		}
+	}
+	return result
+}
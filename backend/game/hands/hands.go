@@ -0,0 +1,347 @@
+package hands
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"balatro-backend/game/cards"
+	"balatro-backend/models"
+)
+
+// HandType poker eli türü
+type HandType string
+
+// Poker eli türleri (frontend PokerHands.js HAND_TYPES ile aynı)
+const (
+	RoyalFlush    HandType = "ROYAL_FLUSH"
+	StraightFlush HandType = "STRAIGHT_FLUSH"
+	FourOfAKind   HandType = "FOUR_OF_A_KIND"
+	FullHouse     HandType = "FULL_HOUSE"
+	Flush         HandType = "FLUSH"
+	Straight      HandType = "STRAIGHT"
+	ThreeOfAKind  HandType = "THREE_OF_A_KIND"
+	TwoPair       HandType = "TWO_PAIR"
+	Pair          HandType = "PAIR"
+	HighCard      HandType = "HIGH_CARD"
+)
+
+// HandInfo el türünün temel değerleri
+type HandInfo struct {
+	Type           HandType `json:"type"`
+	Name           string   `json:"name"`
+	Rank           int      `json:"rank"`
+	BaseChips      int      `json:"baseChips"`
+	BaseMultiplier int      `json:"baseMultiplier"`
+}
+
+// handTable güçten zayıfa doğru sıralanmış el tablosu
+var handTable = []HandInfo{
+	{Type: RoyalFlush, Name: "Royal Flush", Rank: 10, BaseChips: 100, BaseMultiplier: 8},
+	{Type: StraightFlush, Name: "Straight Flush", Rank: 9, BaseChips: 100, BaseMultiplier: 8},
+	{Type: FourOfAKind, Name: "Four of a Kind", Rank: 8, BaseChips: 60, BaseMultiplier: 7},
+	{Type: FullHouse, Name: "Full House", Rank: 7, BaseChips: 40, BaseMultiplier: 4},
+	{Type: Flush, Name: "Flush", Rank: 6, BaseChips: 35, BaseMultiplier: 4},
+	{Type: Straight, Name: "Straight", Rank: 5, BaseChips: 30, BaseMultiplier: 4},
+	{Type: ThreeOfAKind, Name: "Three of a Kind", Rank: 4, BaseChips: 30, BaseMultiplier: 3},
+	{Type: TwoPair, Name: "Two Pair", Rank: 3, BaseChips: 20, BaseMultiplier: 2},
+	{Type: Pair, Name: "Pair", Rank: 2, BaseChips: 10, BaseMultiplier: 2},
+	{Type: HighCard, Name: "High Card", Rank: 1, BaseChips: 5, BaseMultiplier: 1},
+}
+
+// ErrNoCards değerlendirilecek kart yoksa döner
+var ErrNoCards = errors.New("değerlendirilecek kart yok")
+
+// All tüm el türlerini güçten zayıfa doğru döndürür
+func All() []HandInfo {
+	result := make([]HandInfo, len(handTable))
+	copy(result, handTable)
+	return result
+}
+
+// Info el türünün temel değerlerini döndürür
+func Info(handType HandType) (HandInfo, bool) {
+	for _, info := range handTable {
+		if info.Type == handType {
+			return info, true
+		}
+	}
+	return HandInfo{}, false
+}
+
+// Result poker eli değerlendirme sonucu
+type Result struct {
+	HandInfo
+	ScoringCards   []models.Card `json:"scoringCards"`   // Puana katılan kartlar
+	ScoringIndices []int         `json:"scoringIndices"` // Puana katılan kartların oynanan kartlardaki indeksleri
+	Description    string        `json:"description"`
+}
+
+// indexedCard kartı oynanma sırasındaki indeksi ile birlikte tutar
+type indexedCard struct {
+	index int
+	card  models.Card
+}
+
+// Evaluate oynanan kartlardaki en güçlü poker elini bulur.
+// Stone kartlar el tespitine katılmaz ama her zaman puana dahil edilir,
+// Wild kartlar her türün yerine geçer.
+func Evaluate(played []models.Card) (*Result, error) {
+	if len(played) == 0 {
+		return nil, ErrNoCards
+	}
+
+	// Stone kartları ayır, kalanları büyükten küçüğe sırala
+	var ranked []indexedCard
+	var stones []int
+	for i, card := range played {
+		if cards.IsStone(card) {
+			stones = append(stones, i)
+			continue
+		}
+		ranked = append(ranked, indexedCard{index: i, card: card})
+	}
+	sort.SliceStable(ranked, func(a, b int) bool {
+		return cards.NumericValue(ranked[a].card.Value) > cards.NumericValue(ranked[b].card.Value)
+	})
+
+	// Tüm el türlerini kontrol et (güçlüden zayıfa)
+	checks := []func([]indexedCard) (HandType, []indexedCard, string){
+		checkRoyalFlush,
+		checkStraightFlush,
+		checkFourOfAKind,
+		checkFullHouse,
+		checkFlush,
+		checkStraight,
+		checkThreeOfAKind,
+		checkTwoPair,
+		checkPair,
+		checkHighCard,
+	}
+
+	handType := HighCard
+	var handCards []indexedCard
+	description := "Stone"
+	for _, check := range checks {
+		if t, matched, desc := check(ranked); matched != nil {
+			handType, handCards, description = t, matched, desc
+			break
+		}
+	}
+
+	// Puana katılan kartlar: elin kartları + tüm Stone kartlar (oynanma sırasıyla)
+	indices := stones
+	for _, ic := range handCards {
+		indices = append(indices, ic.index)
+	}
+	sort.Ints(indices)
+
+	info, _ := Info(handType)
+	result := &Result{
+		HandInfo:       info,
+		ScoringCards:   make([]models.Card, 0, len(indices)),
+		ScoringIndices: indices,
+		Description:    description,
+	}
+	for _, i := range indices {
+		result.ScoringCards = append(result.ScoringCards, played[i])
+	}
+
+	return result, nil
+}
+
+// Yardımcı fonksiyonlar
+
+// valueGroups kartları değere göre gruplar, değerleri büyükten küçüğe döndürür
+func valueGroups(ranked []indexedCard) (map[string][]indexedCard, []string) {
+	groups := map[string][]indexedCard{}
+	var order []string
+	for _, ic := range ranked {
+		if _, ok := groups[ic.card.Value]; !ok {
+			order = append(order, ic.card.Value)
+		}
+		groups[ic.card.Value] = append(groups[ic.card.Value], ic)
+	}
+	return groups, order
+}
+
+// suitGroups kartları türe göre gruplar; Wild kartlar her gruba eklenir
+func suitGroups(ranked []indexedCard) map[string][]indexedCard {
+	groups := map[string][]indexedCard{}
+	for _, ic := range ranked {
+		for _, suit := range cards.Suits {
+			if ic.card.Suit == suit || cards.IsWild(ic.card) {
+				groups[suit] = append(groups[suit], ic)
+			}
+		}
+	}
+	return groups
+}
+
+// findStraight en yüksek 5'li ardışık diziyi bulur (A-2-3-4-5 dahil)
+func findStraight(ranked []indexedCard) []indexedCard {
+	byValue := map[int]indexedCard{}
+	var unique []int
+	for _, ic := range ranked {
+		v := cards.NumericValue(ic.card.Value)
+		if _, ok := byValue[v]; !ok {
+			byValue[v] = ic
+			unique = append(unique, v)
+		}
+	}
+	if len(unique) < 5 {
+		return nil
+	}
+
+	// Normal straight kontrolü (unique büyükten küçüğe sıralı)
+	for i := 0; i+5 <= len(unique); i++ {
+		if unique[i]-unique[i+4] == 4 {
+			straight := make([]indexedCard, 0, 5)
+			for _, v := range unique[i : i+5] {
+				straight = append(straight, byValue[v])
+			}
+			return straight
+		}
+	}
+
+	// Düşük As straight kontrolü (A-2-3-4-5)
+	wheel := []int{5, 4, 3, 2, 14}
+	straight := make([]indexedCard, 0, 5)
+	for _, v := range wheel {
+		ic, ok := byValue[v]
+		if !ok {
+			return nil
+		}
+		straight = append(straight, ic)
+	}
+	return straight
+}
+
+// El kontrol fonksiyonları
+
+func checkRoyalFlush(ranked []indexedCard) (HandType, []indexedCard, string) {
+	for _, suit := range cards.Suits {
+		suitCards := suitGroups(ranked)[suit]
+		if len(suitCards) < 5 {
+			continue
+		}
+		straight := findStraight(suitCards)
+		if straight != nil && cards.NumericValue(straight[0].card.Value) == 14 {
+			return RoyalFlush, straight, fmt.Sprintf("Royal Flush in %s", suit)
+		}
+	}
+	return "", nil, ""
+}
+
+func checkStraightFlush(ranked []indexedCard) (HandType, []indexedCard, string) {
+	for _, suit := range cards.Suits {
+		suitCards := suitGroups(ranked)[suit]
+		if len(suitCards) < 5 {
+			continue
+		}
+		if straight := findStraight(suitCards); straight != nil {
+			return StraightFlush, straight, fmt.Sprintf("Straight Flush in %s", suit)
+		}
+	}
+	return "", nil, ""
+}
+
+func checkFourOfAKind(ranked []indexedCard) (HandType, []indexedCard, string) {
+	groups, order := valueGroups(ranked)
+	for _, value := range order {
+		if len(groups[value]) >= 4 {
+			return FourOfAKind, groups[value][:4], fmt.Sprintf("Four %ss", value)
+		}
+	}
+	return "", nil, ""
+}
+
+func checkFullHouse(ranked []indexedCard) (HandType, []indexedCard, string) {
+	groups, order := valueGroups(ranked)
+
+	// Three of a kind bul
+	threeValue := ""
+	for _, value := range order {
+		if len(groups[value]) >= 3 {
+			threeValue = value
+			break
+		}
+	}
+	if threeValue == "" {
+		return "", nil, ""
+	}
+
+	// Pair bul (three of a kind'dan farklı)
+	for _, value := range order {
+		if value != threeValue && len(groups[value]) >= 2 {
+			matched := append([]indexedCard{}, groups[threeValue][:3]...)
+			matched = append(matched, groups[value][:2]...)
+			return FullHouse, matched, fmt.Sprintf("Full House: %ss over %ss", threeValue, value)
+		}
+	}
+	return "", nil, ""
+}
+
+func checkFlush(ranked []indexedCard) (HandType, []indexedCard, string) {
+	groups := suitGroups(ranked)
+	for _, suit := range cards.Suits {
+		if len(groups[suit]) >= 5 {
+			return Flush, groups[suit][:5], fmt.Sprintf("Flush in %s", suit)
+		}
+	}
+	return "", nil, ""
+}
+
+func checkStraight(ranked []indexedCard) (HandType, []indexedCard, string) {
+	if straight := findStraight(ranked); straight != nil {
+		return Straight, straight, fmt.Sprintf("Straight to %s", straight[0].card.Value)
+	}
+	return "", nil, ""
+}
+
+func checkThreeOfAKind(ranked []indexedCard) (HandType, []indexedCard, string) {
+	groups, order := valueGroups(ranked)
+	for _, value := range order {
+		if len(groups[value]) >= 3 {
+			return ThreeOfAKind, groups[value][:3], fmt.Sprintf("Three %ss", value)
+		}
+	}
+	return "", nil, ""
+}
+
+func checkTwoPair(ranked []indexedCard) (HandType, []indexedCard, string) {
+	groups, order := valueGroups(ranked)
+
+	// En yüksek iki pairi al (order zaten büyükten küçüğe)
+	var pairs []string
+	for _, value := range order {
+		if len(groups[value]) >= 2 {
+			pairs = append(pairs, value)
+		}
+	}
+	if len(pairs) < 2 {
+		return "", nil, ""
+	}
+
+	matched := append([]indexedCard{}, groups[pairs[0]][:2]...)
+	matched = append(matched, groups[pairs[1]][:2]...)
+	return TwoPair, matched, fmt.Sprintf("Two Pair: %ss and %ss", pairs[0], pairs[1])
+}
+
+func checkPair(ranked []indexedCard) (HandType, []indexedCard, string) {
+	groups, order := valueGroups(ranked)
+	for _, value := range order {
+		if len(groups[value]) >= 2 {
+			return Pair, groups[value][:2], fmt.Sprintf("Pair of %ss", value)
+		}
+	}
+	return "", nil, ""
+}
+
+func checkHighCard(ranked []indexedCard) (HandType, []indexedCard, string) {
+	if len(ranked) == 0 {
+		return "", nil, ""
+	}
+	// ranked büyükten küçüğe sıralı, ilk kart en yüksek
+	return HighCard, ranked[:1], fmt.Sprintf("%s High", ranked[0].card.Value)
+}
@@ -0,0 +1,198 @@
+package hands
+
+import (
+	"reflect"
+	"testing"
+
+	"balatro-backend/game/cards"
+	"balatro-backend/models"
+)
+
+// card test için kart oluşturur
+func card(value, suit string, enhancements ...models.Enhancement) models.Card {
+	return models.Card{Suit: suit, Value: value, Enhancements: enhancements}
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name    string
+		played  []models.Card
+		want    HandType
+		scoring []int // Puana katılan kartların oynanan kartlardaki indeksleri
+	}{
+		{
+			name: "royal flush",
+			played: []models.Card{
+				card(cards.Ten, cards.Hearts), card(cards.Jack, cards.Hearts), card(cards.Queen, cards.Hearts),
+				card(cards.King, cards.Hearts), card(cards.Ace, cards.Hearts),
+			},
+			want:    RoyalFlush,
+			scoring: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "straight flush to king is not royal",
+			played: []models.Card{
+				card(cards.Nine, cards.Spades), card(cards.Ten, cards.Spades), card(cards.Jack, cards.Spades),
+				card(cards.Queen, cards.Spades), card(cards.King, cards.Spades),
+			},
+			want:    StraightFlush,
+			scoring: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "low ace straight flush is not royal",
+			played: []models.Card{
+				card(cards.Ace, cards.Clubs), card(cards.Two, cards.Clubs), card(cards.Three, cards.Clubs),
+				card(cards.Four, cards.Clubs), card(cards.Five, cards.Clubs),
+			},
+			want:    StraightFlush,
+			scoring: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "four of a kind",
+			played: []models.Card{
+				card(cards.Seven, cards.Spades), card(cards.Seven, cards.Hearts), card(cards.Two, cards.Clubs),
+				card(cards.Seven, cards.Diamonds), card(cards.Seven, cards.Clubs),
+			},
+			want:    FourOfAKind,
+			scoring: []int{0, 1, 3, 4},
+		},
+		{
+			name: "full house",
+			played: []models.Card{
+				card(cards.King, cards.Spades), card(cards.King, cards.Hearts), card(cards.Four, cards.Clubs),
+				card(cards.Four, cards.Diamonds), card(cards.King, cards.Clubs),
+			},
+			want:    FullHouse,
+			scoring: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "flush",
+			played: []models.Card{
+				card(cards.Two, cards.Diamonds), card(cards.Nine, cards.Diamonds), card(cards.Jack, cards.Diamonds),
+				card(cards.Four, cards.Diamonds), card(cards.Ace, cards.Diamonds),
+			},
+			want:    Flush,
+			scoring: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "straight",
+			played: []models.Card{
+				card(cards.Eight, cards.Spades), card(cards.Six, cards.Hearts), card(cards.Seven, cards.Clubs),
+				card(cards.Five, cards.Diamonds), card(cards.Four, cards.Spades),
+			},
+			want:    Straight,
+			scoring: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "low ace straight",
+			played: []models.Card{
+				card(cards.Ace, cards.Spades), card(cards.Two, cards.Hearts), card(cards.Three, cards.Clubs),
+				card(cards.Four, cards.Diamonds), card(cards.Five, cards.Spades),
+			},
+			want:    Straight,
+			scoring: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "wrap around is not a straight",
+			played: []models.Card{
+				card(cards.Queen, cards.Spades), card(cards.King, cards.Hearts), card(cards.Ace, cards.Clubs),
+				card(cards.Two, cards.Diamonds), card(cards.Three, cards.Spades),
+			},
+			want:    HighCard,
+			scoring: []int{2},
+		},
+		{
+			name: "three of a kind",
+			played: []models.Card{
+				card(cards.Nine, cards.Spades), card(cards.Nine, cards.Hearts), card(cards.Nine, cards.Clubs),
+				card(cards.Two, cards.Diamonds),
+			},
+			want:    ThreeOfAKind,
+			scoring: []int{0, 1, 2},
+		},
+		{
+			name: "two pair",
+			played: []models.Card{
+				card(cards.Three, cards.Spades), card(cards.Jack, cards.Hearts), card(cards.Three, cards.Clubs),
+				card(cards.Jack, cards.Diamonds), card(cards.Ace, cards.Spades),
+			},
+			want:    TwoPair,
+			scoring: []int{0, 1, 2, 3},
+		},
+		{
+			name:    "pair",
+			played:  []models.Card{card(cards.Ten, cards.Spades), card(cards.Ten, cards.Hearts), card(cards.Four, cards.Clubs)},
+			want:    Pair,
+			scoring: []int{0, 1},
+		},
+		{
+			name:    "high card",
+			played:  []models.Card{card(cards.Two, cards.Spades), card(cards.Queen, cards.Hearts), card(cards.Seven, cards.Clubs)},
+			want:    HighCard,
+			scoring: []int{1},
+		},
+		{
+			name: "wild card completes flush",
+			played: []models.Card{
+				card(cards.Two, cards.Hearts), card(cards.Six, cards.Hearts), card(cards.Nine, cards.Hearts),
+				card(cards.Jack, cards.Hearts), card(cards.King, cards.Spades, cards.Wild),
+			},
+			want:    Flush,
+			scoring: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "wild card completes royal flush",
+			played: []models.Card{
+				card(cards.Ten, cards.Clubs, cards.Wild), card(cards.Jack, cards.Diamonds), card(cards.Queen, cards.Diamonds),
+				card(cards.King, cards.Diamonds), card(cards.Ace, cards.Diamonds),
+			},
+			want:    RoyalFlush,
+			scoring: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "stone cards always score",
+			played: []models.Card{
+				card(cards.Five, cards.Spades, cards.Stone), card(cards.Eight, cards.Hearts), card(cards.Eight, cards.Clubs),
+				card(cards.Two, cards.Diamonds, cards.Stone),
+			},
+			want:    Pair,
+			scoring: []int{0, 1, 2, 3},
+		},
+		{
+			name:    "stone cards do not form hands",
+			played:  []models.Card{card(cards.Ace, cards.Spades, cards.Stone), card(cards.Ace, cards.Hearts, cards.Stone)},
+			want:    HighCard,
+			scoring: []int{0, 1},
+		},
+	}
+
+	covered := map[HandType]bool{}
+	for _, tt := range tests {
+		covered[tt.want] = true
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Evaluate(tt.played)
+			if err != nil {
+				t.Fatalf("Evaluate: %v", err)
+			}
+			if result.Type != tt.want {
+				t.Errorf("hand = %s, want %s", result.Type, tt.want)
+			}
+			if !reflect.DeepEqual(result.ScoringIndices, tt.scoring) {
+				t.Errorf("scoring indices = %v, want %v", result.ScoringIndices, tt.scoring)
+			}
+		})
+	}
+
+	// Tablodaki her el türü en az bir durumla test edilmeli
+	for _, info := range All() {
+		if !covered[info.Type] {
+			t.Errorf("no test case for %s", info.Type)
+		}
+	}
+}
+
+func TestEvaluateNoCards(t *testing.T) {
+	if _, err := Evaluate(nil); err != ErrNoCards {
+		t.Fatalf("err = %v, want ErrNoCards", err)
+	}
+}
@@ -0,0 +1,143 @@
+package jokers
+
+import (
+	"balatro-backend/game/cards"
+	"balatro-backend/game/hands"
+)
+
+// Temel joker tanımları (frontend Joker.js JOKER_DEFINITIONS ile aynı ID'ler).
+// Yeni bir joker eklemek için buraya Register çağrısı eklemek yeterlidir.
+func init() {
+	Register(&Definition{
+		ID:          "red_card",
+		Name:        "Red Card",
+		Description: "Her Kupa veya Karo kartı +4 Çip verir",
+		Rarity:      Common,
+		Trigger:     OnCardPlayed,
+		Effect: func(ctx Context) Effect {
+			if ctx.Card.Suit == cards.Hearts || ctx.Card.Suit == cards.Diamonds {
+				return Effect{Chips: 4}
+			}
+			return Effect{}
+		},
+	})
+
+	Register(&Definition{
+		ID:          "odd_todd",
+		Name:        "Odd Todd",
+		Description: "Her tek sayılı kart +2 Çarpan verir",
+		Rarity:      Common,
+		Trigger:     OnCardPlayed,
+		Effect: func(ctx Context) Effect {
+			switch ctx.Card.Value {
+			case cards.Three, cards.Five, cards.Seven, cards.Nine:
+				return Effect{Mult: 2}
+			}
+			return Effect{}
+		},
+	})
+
+	Register(&Definition{
+		ID:          "greedy_joker",
+		Name:        "Greedy Joker",
+		Description: "Flush eli oynadığınızda +20 Çip kazanırsınız",
+		Rarity:      Uncommon,
+		Trigger:     OnHandPlayed,
+		Effect: func(ctx Context) Effect {
+			if ctx.Hand != nil && ctx.Hand.Type == hands.Flush {
+				return Effect{Chips: 20}
+			}
+			return Effect{}
+		},
+	})
+
+	Register(&Definition{
+		ID:          "golden_joker",
+		Name:        "Golden Joker",
+		Description: "Blind sonunda $4 kazandırır",
+		Rarity:      Common,
+		Trigger:     OnRoundEnd,
+		Effect: func(ctx Context) Effect {
+			return Effect{Money: 4}
+		},
+	})
+
+	Register(&Definition{
+		ID:          "fibonacci",
+		Name:        "Fibonacci",
+		Description: "Fibonacci sayısı kartlar (2,3,5,8) +3 Çarpan verir",
+		Rarity:      Rare,
+		Trigger:     OnCardPlayed,
+		Effect: func(ctx Context) Effect {
+			switch ctx.Card.Value {
+			case cards.Two, cards.Three, cards.Five, cards.Eight:
+				return Effect{Mult: 3}
+			}
+			return Effect{}
+		},
+	})
+
+	Register(&Definition{
+		ID:          "perfectionist",
+		Name:        "Perfectionist",
+		Description: "Bu turda Can kaybetmediyseniz +5 Çarpan",
+		Rarity:      Legendary,
+		Trigger:     OnScoreCalc,
+		Effect: func(ctx Context) Effect {
+			if ctx.NoLivesLost {
+				return Effect{Mult: 5}
+			}
+			return Effect{}
+		},
+	})
+
+	Register(&Definition{
+		ID:          "juggler",
+		Name:        "Juggler",
+		Description: "Oyun başında +1 Discard hakkı verir",
+		Rarity:      Common,
+		Trigger:     Passive,
+		Effect: func(ctx Context) Effect {
+			return Effect{ExtraDiscards: 1}
+		},
+	})
+
+	Register(&Definition{
+		ID:          "rough_gem",
+		Name:        "Rough Gem",
+		Description: "Puana katılan her Karo kartı $1 kazandırır",
+		Rarity:      Uncommon,
+		Trigger:     OnCardPlayed,
+		Effect: func(ctx Context) Effect {
+			if ctx.Card.Suit == cards.Diamonds {
+				return Effect{Money: 1}
+			}
+			return Effect{}
+		},
+	})
+
+	Register(&Definition{
+		ID:          "baron",
+		Name:        "Baron",
+		Description: "Elde tutulan her Papaz x1.5 Çarpan verir",
+		Rarity:      Rare,
+		Trigger:     OnHeld,
+		Effect: func(ctx Context) Effect {
+			if ctx.Card.Value == cards.King {
+				return Effect{XMult: 1.5}
+			}
+			return Effect{}
+		},
+	})
+
+	Register(&Definition{
+		ID:          "cavendish",
+		Name:        "Cavendish",
+		Description: "x3 Çarpan",
+		Rarity:      Legendary,
+		Trigger:     OnScoreCalc,
+		Effect: func(ctx Context) Effect {
+			return Effect{XMult: 3}
+		},
+	})
+}
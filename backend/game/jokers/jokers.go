@@ -0,0 +1,221 @@
+package jokers
+
+import (
+	"fmt"
+	"sort"
+
+	"balatro-backend/game/hands"
+	"balatro-backend/models"
+)
+
+// Trigger joker tetikleme koşulu
+type Trigger string
+
+// Joker tetikleme koşulları (frontend Joker.js TRIGGER_CONDITIONS ile aynı)
+const (
+	OnPlay       Trigger = "ON_PLAY"        // Her el oynadığında
+	OnDiscard    Trigger = "ON_DISCARD"     // Discard kullandığında
+	OnHandPlayed Trigger = "ON_HAND_PLAYED" // Belirli el türü oynadığında
+	OnCardPlayed Trigger = "ON_CARD_PLAYED" // Puana katılan her kart için
+	OnHeld       Trigger = "ON_HELD"        // Elde tutulan her kart için
+	OnScoreCalc  Trigger = "ON_SCORE_CALC"  // Puan hesaplanırken
+	OnRoundEnd   Trigger = "ON_ROUND_END"   // Blind tamamlandığında (ödeme sırasında)
+	Passive      Trigger = "PASSIVE"        // Sürekli aktif
+)
+
+// Joker nadirlikleri
+const (
+	Common    = "common"
+	Uncommon  = "uncommon"
+	Rare      = "rare"
+	Legendary = "legendary"
+)
+
+// Effect joker efektinin sonucu
+type Effect struct {
+	Chips         int     `json:"chips,omitempty"`
+	Mult          float64 `json:"mult,omitempty"`
+	XMult         float64 `json:"xMult,omitempty"` // 0 ise çarpım uygulanmaz
+	Money         int     `json:"money,omitempty"`
+	ExtraHands    int     `json:"extraHands,omitempty"`
+	ExtraDiscards int     `json:"extraDiscards,omitempty"`
+}
+
+// IsZero efektin hiçbir şey değiştirmediğini kontrol eder
+func (e Effect) IsZero() bool {
+	return e == Effect{}
+}
+
+// Add iki efekti toplar (çarpımlar birbiriyle çarpılır)
+func (e Effect) Add(other Effect) Effect {
+	xMult := e.XMult
+	if other.XMult != 0 {
+		if xMult == 0 {
+			xMult = 1
+		}
+		xMult *= other.XMult
+	}
+	return Effect{
+		Chips:         e.Chips + other.Chips,
+		Mult:          e.Mult + other.Mult,
+		XMult:         xMult,
+		Money:         e.Money + other.Money,
+		ExtraHands:    e.ExtraHands + other.ExtraHands,
+		ExtraDiscards: e.ExtraDiscards + other.ExtraDiscards,
+	}
+}
+
+// Context joker efektlerine verilen oyun bilgisi
+type Context struct {
+	Hand        *hands.Result // Oynanan elin sonucu (varsa)
+	Played      []models.Card // Oynanan kartlar
+	Held        []models.Card // Elde tutulan kartlar
+	Discarded   []models.Card // Discard edilen kartlar
+	Card        *models.Card  // ON_CARD_PLAYED / ON_HELD için mevcut kart
+	NoLivesLost bool          // Bu turda can kaybedilmedi mi
+}
+
+// Definition joker tanımı
+type Definition struct {
+	ID          string                   `json:"id"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	Rarity      string                   `json:"rarity"`
+	Trigger     Trigger                  `json:"trigger"`
+	Effect      func(ctx Context) Effect `json:"-"`
+}
+
+// Price joker'ın dükkandaki fiyatını nadirliğine göre döndürür
+func (d *Definition) Price() int {
+	prices := map[string]int{
+		Common:    5,
+		Uncommon:  8,
+		Rare:      12,
+		Legendary: 20,
+	}
+	if price, ok := prices[d.Rarity]; ok {
+		return price
+	}
+	return 5
+}
+
+// SellValue joker'ın satış değerini döndürür
+func (d *Definition) SellValue() int {
+	baseValues := map[string]int{
+		Common:    2,
+		Uncommon:  5,
+		Rare:      8,
+		Legendary: 15,
+	}
+	if value, ok := baseValues[d.Rarity]; ok {
+		return value
+	}
+	return 2
+}
+
+// registry kayıtlı joker tanımları
+var registry = map[string]*Definition{}
+
+// Register yeni bir joker tanımı kaydeder
+func Register(def *Definition) {
+	if def.ID == "" || def.Effect == nil {
+		panic("joker tanımı eksik: ID ve Effect gerekli")
+	}
+	if _, exists := registry[def.ID]; exists {
+		panic(fmt.Sprintf("joker zaten kayıtlı: %s", def.ID))
+	}
+	registry[def.ID] = def
+}
+
+// Get ID ile joker tanımını döndürür
+func Get(id string) (*Definition, bool) {
+	def, ok := registry[id]
+	return def, ok
+}
+
+// IsKnown joker ID'sinin kayıtlı olup olmadığını kontrol eder
+func IsKnown(id string) bool {
+	_, ok := registry[id]
+	return ok
+}
+
+// All tüm joker tanımlarını ID sırasıyla döndürür
+func All() []*Definition {
+	result := make([]*Definition, 0, len(registry))
+	for _, def := range registry {
+		result = append(result, def)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+// ByRarity belirli nadirlikteki joker tanımlarını ID sırasıyla döndürür
+func ByRarity(rarity string) []*Definition {
+	var result []*Definition
+	for _, def := range All() {
+		if def.Rarity == rarity {
+			result = append(result, def)
+		}
+	}
+	return result
+}
+
+// Evaluate joker efektini istatistikleri güncellemeden hesaplar.
+// Pasif veya tanımsız jokerler boş efekt döndürür.
+func Evaluate(joker models.Joker, trigger Trigger, ctx Context) Effect {
+	if !joker.IsActive {
+		return Effect{}
+	}
+	def, ok := registry[joker.ID]
+	if !ok || def.Trigger != trigger {
+		return Effect{}
+	}
+	return def.Effect(ctx)
+}
+
+// RecordTrigger joker istatistiklerini günceller (frontend Joker.trigger ile aynı anahtarlar)
+func RecordTrigger(joker *models.Joker, effect Effect) {
+	if joker.Stats == nil {
+		joker.Stats = map[string]interface{}{}
+	}
+	AddStat(joker.Stats, "timesTriggered", 1)
+	AddStat(joker.Stats, "totalChipsAdded", float64(effect.Chips))
+	AddStat(joker.Stats, "totalMultiplierAdded", effect.Mult)
+	if effect.Money != 0 {
+		AddStat(joker.Stats, "totalMoneyAdded", float64(effect.Money))
+	}
+}
+
+// Collect belirli tetikleyicideki tüm jokerleri çalıştırır, istatistikleri günceller
+// ve toplam efekti döndürür
+func Collect(jokerList []models.Joker, trigger Trigger, ctx Context) Effect {
+	total := Effect{}
+	for i := range jokerList {
+		def, ok := registry[jokerList[i].ID]
+		if !ok || !jokerList[i].IsActive || def.Trigger != trigger {
+			continue
+		}
+		effect := def.Effect(ctx)
+		RecordTrigger(&jokerList[i], effect)
+		total = total.Add(effect)
+	}
+	return total
+}
+
+// AddStat sayısal bir istatistiği artırır (JSON/BSON'dan gelen farklı sayı tiplerini destekler)
+func AddStat(stats map[string]interface{}, key string, delta float64) {
+	current := 0.0
+	switch v := stats[key].(type) {
+	case float64:
+		current = v
+	case float32:
+		current = float64(v)
+	case int:
+		current = float64(v)
+	case int32:
+		current = float64(v)
+	case int64:
+		current = float64(v)
+	}
+	stats[key] = current + delta
+}
@@ -0,0 +1,134 @@
+package packs
+
+import (
+	"sort"
+
+	"balatro-backend/game/cards"
+	"balatro-backend/game/planets"
+	"balatro-backend/game/rng"
+	"balatro-backend/game/tarots"
+	"balatro-backend/models"
+)
+
+// Paket türleri (frontend ShopScene.js paket ID'leri ile aynı)
+const (
+	Arcana    = "arcana_pack"    // Tarot kartları
+	Celestial = "celestial_pack" // Planet kartları
+	Standard  = "standard_pack"  // Oyun kartları
+)
+
+// Definition booster paket tanımı
+type Definition struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Size        int    `json:"size"`  // Paketten çıkan seçenek sayısı
+	Picks       int    `json:"picks"` // Seçilebilecek seçenek sayısı
+	Price       int    `json:"price"`
+}
+
+// catalog tüm paketler
+var catalog = map[string]*Definition{
+	Arcana: {
+		ID:          Arcana,
+		Name:        "Arcana Pack",
+		Description: "4 Tarot kartı içerir",
+		Size:        4,
+		Picks:       1,
+		Price:       10,
+	},
+	Celestial: {
+		ID:          Celestial,
+		Name:        "Celestial Pack",
+		Description: "4 Gezegen kartı içerir",
+		Size:        4,
+		Picks:       1,
+		Price:       10,
+	},
+	Standard: {
+		ID:          Standard,
+		Name:        "Standard Pack",
+		Description: "4 rastgele kart içerir",
+		Size:        4,
+		Picks:       1,
+		Price:       10,
+	},
+}
+
+// Get ID ile paket tanımını döndürür
+func Get(id string) (*Definition, bool) {
+	def, ok := catalog[id]
+	return def, ok
+}
+
+// IsKnown paket ID'sinin tanımlı olup olmadığını kontrol eder
+func IsKnown(id string) bool {
+	_, ok := catalog[id]
+	return ok
+}
+
+// All tüm paket tanımlarını ID sırasıyla döndürür
+func All() []*Definition {
+	result := make([]*Definition, 0, len(catalog))
+	for _, def := range catalog {
+		result = append(result, def)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+// Teklif tipleri
+const (
+	OfferTarot  = "tarot"
+	OfferPlanet = "planet"
+	OfferCard   = "card"
+)
+
+// Standard paketteki bir kartın enhancement, edition ve seal alma olasılıkları (1/n)
+const (
+	enhancementChance = 3
+	editionChance     = 10
+	sealChance        = 10
+)
+
+// Roll paketin içeriğini verilen RNG ile üretir. Paketler yalnızca tarot,
+// planet ve oyun kartı sunar; bunların hiçbiri kilitli olamadığından profil
+// kilitleri yalnızca dükkanda (shop.Options) uygulanır.
+func Roll(def *Definition, r *rng.RNG) []models.PackOffer {
+	offers := make([]models.PackOffer, 0, def.Size)
+	for i := 0; i < def.Size; i++ {
+		switch def.ID {
+		case Arcana:
+			all := tarots.All()
+			tarot := all[r.Intn(len(all))]
+			offers = append(offers, models.PackOffer{Type: OfferTarot, ItemID: tarot.ID, Name: tarot.Name})
+		case Celestial:
+			all := planets.All()
+			planet := all[r.Intn(len(all))]
+			offers = append(offers, models.PackOffer{Type: OfferPlanet, ItemID: planet.ID, Name: planet.Name})
+		default:
+			card := randomCard(r)
+			offers = append(offers, models.PackOffer{Type: OfferCard, Name: card.Value + " " + card.Suit, Card: &card})
+		}
+	}
+	return offers
+}
+
+// randomCard rastgele, bazen enhancement, edition veya seal'lı bir oyun kartı üretir
+func randomCard(r *rng.RNG) models.Card {
+	card := models.Card{
+		Suit:         cards.Suits[r.Intn(len(cards.Suits))],
+		Value:        cards.Values[r.Intn(len(cards.Values))],
+		Enhancements: []models.Enhancement{},
+	}
+	if r.Chance(enhancementChance) {
+		card.Enhancements = append(card.Enhancements, cards.Enhancements[r.Intn(len(cards.Enhancements))])
+	}
+	if r.Chance(editionChance) {
+		card.Edition = cards.Editions[r.Intn(len(cards.Editions))]
+	}
+	if r.Chance(sealChance) {
+		card.Seal = cards.Seals[r.Intn(len(cards.Seals))]
+	}
+	return card
+}
@@ -0,0 +1,176 @@
+package planets
+
+import (
+	"errors"
+	"sort"
+
+	"balatro-backend/game/hands"
+	"balatro-backend/models"
+)
+
+// Planet hataları
+var (
+	ErrUnknownPlanet  = errors.New("bilinmeyen planet kartı")
+	ErrNotInInventory = errors.New("planet kartı envanterde yok")
+)
+
+// Definition planet kartı tanımı
+type Definition struct {
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	HandType      hands.HandType `json:"handType"`
+	HandName      string         `json:"handName"`
+	ChipsPerLevel int            `json:"chipsPerLevel"` // Seviye başına çip artışı
+	MultPerLevel  int            `json:"multPerLevel"`  // Seviye başına çarpan artışı
+	Price         int            `json:"price"`
+}
+
+// catalog tüm planet kartları. Her planet frontend PlanetCard.js PLANET_TYPES'taki
+// gibi seviye başına ya yalnızca çip ya da yalnızca çarpan ekler; değerler
+// oradaki bonusType/bonusAmount ile birebir aynıdır.
+var catalog = []Definition{
+	{ID: "planet_mercury", Name: "Mercury", HandType: hands.Pair, ChipsPerLevel: 15},
+	{ID: "planet_venus", Name: "Venus", HandType: hands.TwoPair, MultPerLevel: 1},
+	{ID: "planet_earth", Name: "Earth", HandType: hands.ThreeOfAKind, ChipsPerLevel: 20},
+	{ID: "planet_mars", Name: "Mars", HandType: hands.FourOfAKind, ChipsPerLevel: 25},
+	{ID: "planet_jupiter", Name: "Jupiter", HandType: hands.Flush, MultPerLevel: 2},
+	{ID: "planet_saturn", Name: "Saturn", HandType: hands.Straight, ChipsPerLevel: 30},
+	{ID: "planet_uranus", Name: "Uranus", HandType: hands.FullHouse, MultPerLevel: 2},
+	{ID: "planet_neptune", Name: "Neptune", HandType: hands.StraightFlush, ChipsPerLevel: 40},
+	{ID: "planet_pluto", Name: "Pluto", HandType: hands.RoyalFlush, MultPerLevel: 3},
+	{ID: "planet_sun", Name: "The Sun", HandType: hands.HighCard, ChipsPerLevel: 10},
+}
+
+// defaultPrice planet kartı fiyatı (frontend PlanetCard.js ile aynı)
+const defaultPrice = 12
+
+func init() {
+	for i := range catalog {
+		info, _ := hands.Info(catalog[i].HandType)
+		catalog[i].HandName = info.Name
+		catalog[i].Price = defaultPrice
+	}
+}
+
+// HandLevel bir el türünün mevcut seviyesi ve taban değerleri
+type HandLevel struct {
+	HandType       hands.HandType `json:"handType"`
+	HandName       string         `json:"handName"`
+	Level          int            `json:"level"` // Kullanılan planet sayısı (0 = temel)
+	BaseChips      int            `json:"baseChips"`
+	BaseMultiplier int            `json:"baseMultiplier"`
+}
+
+// Get ID ile planet tanımını döndürür
+func Get(id string) (*Definition, bool) {
+	for i := range catalog {
+		if catalog[i].ID == id {
+			return &catalog[i], true
+		}
+	}
+	return nil, false
+}
+
+// IsKnown planet ID'sinin tanımlı olup olmadığını kontrol eder
+func IsKnown(id string) bool {
+	_, ok := Get(id)
+	return ok
+}
+
+// ForHand el türünü güçlendiren planet tanımını döndürür
+func ForHand(handType hands.HandType) (*Definition, bool) {
+	for i := range catalog {
+		if catalog[i].HandType == handType {
+			return &catalog[i], true
+		}
+	}
+	return nil, false
+}
+
+// All tüm planet tanımlarını el gücüne göre (zayıftan güçlüye) döndürür
+func All() []Definition {
+	result := make([]Definition, len(catalog))
+	copy(result, catalog)
+	sort.SliceStable(result, func(i, j int) bool {
+		a, _ := hands.Info(result[i].HandType)
+		b, _ := hands.Info(result[j].HandType)
+		return a.Rank < b.Rank
+	})
+	return result
+}
+
+// Bonus el türünün seviyesinden gelen çip ve çarpan bonusunu döndürür
+func Bonus(handType hands.HandType, level int) (chips, mult int) {
+	def, ok := ForHand(handType)
+	if !ok || level <= 0 {
+		return 0, 0
+	}
+	return def.ChipsPerLevel * level, def.MultPerLevel * level
+}
+
+// Level el türünün seviyesini ve seviyeyle artmış taban değerlerini döndürür.
+// PlanetLevels el adına göre tutulur (frontend ile aynı).
+func Level(planetLevels map[string]int, handType hands.HandType) HandLevel {
+	info, _ := hands.Info(handType)
+	level := planetLevels[info.Name]
+	chips, mult := Bonus(handType, level)
+	return HandLevel{
+		HandType:       handType,
+		HandName:       info.Name,
+		Level:          level,
+		BaseChips:      info.BaseChips + chips,
+		BaseMultiplier: info.BaseMultiplier + mult,
+	}
+}
+
+// Levels tüm el türlerinin seviyelerini güçten zayıfa doğru döndürür
+func Levels(planetLevels map[string]int) []HandLevel {
+	var result []HandLevel
+	for _, info := range hands.All() {
+		result = append(result, Level(planetLevels, info.Type))
+	}
+	return result
+}
+
+// Use planet kartını kullanır: ilgili el türünün seviyesini bir artırır
+func Use(state *models.PlayerState, id string) (HandLevel, error) {
+	def, ok := Get(id)
+	if !ok {
+		return HandLevel{}, ErrUnknownPlanet
+	}
+	if state.PlanetLevels == nil {
+		state.PlanetLevels = map[string]int{}
+	}
+	state.PlanetLevels[def.HandName]++
+	return Level(state.PlanetLevels, def.HandType), nil
+}
+
+// UseFromInventory envanterdeki planet kartını kullanır ve envanterden düşer
+func UseFromInventory(state *models.PlayerState, id string) (HandLevel, error) {
+	if !IsKnown(id) {
+		return HandLevel{}, ErrUnknownPlanet
+	}
+
+	slot := -1
+	for i, planet := range state.PlanetCardsInventory {
+		if planet.ID == id && planet.Quantity > 0 {
+			slot = i
+			break
+		}
+	}
+	if slot < 0 {
+		return HandLevel{}, ErrNotInInventory
+	}
+
+	level, err := Use(state, id)
+	if err != nil {
+		return HandLevel{}, err
+	}
+
+	// Envanterden düş
+	state.PlanetCardsInventory[slot].Quantity--
+	if state.PlanetCardsInventory[slot].Quantity == 0 {
+		state.PlanetCardsInventory = append(state.PlanetCardsInventory[:slot], state.PlanetCardsInventory[slot+1:]...)
+	}
+	return level, nil
+}
@@ -0,0 +1,52 @@
+package planets
+
+import (
+	"testing"
+
+	"balatro-backend/game/hands"
+)
+
+// TestCatalogMatchesFrontend değerleri frontend PlanetCard.js PLANET_TYPES'a sabitler
+func TestCatalogMatchesFrontend(t *testing.T) {
+	tests := []struct {
+		id    string
+		hand  hands.HandType
+		chips int
+		mult  int
+	}{
+		{"planet_mercury", hands.Pair, 15, 0},
+		{"planet_venus", hands.TwoPair, 0, 1},
+		{"planet_earth", hands.ThreeOfAKind, 20, 0},
+		{"planet_mars", hands.FourOfAKind, 25, 0},
+		{"planet_jupiter", hands.Flush, 0, 2},
+		{"planet_saturn", hands.Straight, 30, 0},
+		{"planet_uranus", hands.FullHouse, 0, 2},
+		{"planet_neptune", hands.StraightFlush, 40, 0},
+		{"planet_pluto", hands.RoyalFlush, 0, 3},
+		{"planet_sun", hands.HighCard, 10, 0},
+	}
+
+	if len(tests) != len(catalog) {
+		t.Fatalf("catalog has %d planets, want %d", len(catalog), len(tests))
+	}
+	for _, tt := range tests {
+		def, ok := Get(tt.id)
+		if !ok {
+			t.Errorf("%s missing from catalog", tt.id)
+			continue
+		}
+		if def.HandType != tt.hand || def.ChipsPerLevel != tt.chips || def.MultPerLevel != tt.mult {
+			t.Errorf("%s = %s +%d chips +%d mult, want %s +%d chips +%d mult",
+				tt.id, def.HandType, def.ChipsPerLevel, def.MultPerLevel, tt.hand, tt.chips, tt.mult)
+		}
+	}
+}
+
+func TestBonusScalesWithLevel(t *testing.T) {
+	if chips, mult := Bonus(hands.Flush, 3); chips != 0 || mult != 6 {
+		t.Errorf("Bonus(Flush, 3) = %d, %d; want 0, 6", chips, mult)
+	}
+	if chips, mult := Bonus(hands.Pair, 0); chips != 0 || mult != 0 {
+		t.Errorf("Bonus(Pair, 0) = %d, %d; want 0, 0", chips, mult)
+	}
+}
@@ -0,0 +1,150 @@
+package replay
+
+import (
+	"errors"
+	"fmt"
+
+	"balatro-backend/game/run"
+	"balatro-backend/models"
+)
+
+// Aksiyon tipleri
+const (
+	ActionPlay     = "play"
+	ActionDiscard  = "discard"
+	ActionBuy      = "buy"
+	ActionReroll   = "reroll"
+	ActionOpenPack = "open_pack"
+	ActionPickPack = "pick_pack"
+	ActionUseTarot = "use_tarot"
+	ActionAdvance  = "advance"
+)
+
+// MaxActions tek bir kayıttaki en fazla aksiyon sayısı
+const MaxActions = 5000
+
+// Reddetme nedenleri
+const (
+	ReasonMissingReplay = "missing_replay" // Seed veya aksiyon kaydı yok
+	ReasonInvalidReplay = "invalid_replay" // Aksiyonlar kurallara göre oynatılamadı
+	ReasonScoreMismatch = "score_mismatch" // Hesaplanan skor gönderilenle eşleşmiyor
+	ReasonBlindMismatch = "blind_mismatch" // Hesaplanan blind gönderilenle eşleşmiyor
+)
+
+// Doğrulama hataları
+var (
+	ErrMissingReplay  = errors.New("seed ve aksiyon kaydı gerekli")
+	ErrTooManyActions = fmt.Errorf("en fazla %d aksiyon gönderilebilir", MaxActions)
+	ErrUnknownAction  = errors.New("bilinmeyen aksiyon tipi")
+)
+
+// Result yeniden oynatmanın sonucu
+type Result struct {
+	Run        *models.Run `json:"-"`
+	Score      int64       `json:"score"`
+	FinalBlind int         `json:"finalBlind"`
+	JokersUsed []string    `json:"jokersUsed"`
+}
+
+// Verdict gönderilen skorun doğrulama kararı
+type Verdict struct {
+	Accepted bool    `json:"accepted"`
+	Reason   string  `json:"reason,omitempty"`
+	Detail   string  `json:"detail,omitempty"`
+	Result   *Result `json:"result,omitempty"`
+}
+
+// Setup run'ın başlangıç parametreleri
+type Setup struct {
+	Seed   string
+	Deck   string
+	Stake  string
+	Locked map[string][]string // Run başındaki kilitli öğeler (dükkan üretimi için)
+}
+
+// Simulate run'ı başlangıç parametrelerinden başlatıp aksiyonları sırayla deterministik olarak uygular
+func Simulate(setup Setup, actions []models.ReplayAction) (*Result, error) {
+	if setup.Seed == "" || len(actions) == 0 {
+		return nil, ErrMissingReplay
+	}
+	if len(actions) > MaxActions {
+		return nil, ErrTooManyActions
+	}
+
+	current, err := run.New("", setup.Seed, setup.Deck, setup.Stake)
+	if err != nil {
+		return nil, err
+	}
+	current.Locked = setup.Locked
+
+	for i, action := range actions {
+		if err := apply(current, action); err != nil {
+			return nil, fmt.Errorf("aksiyon %d (%s): %w", i, action.Type, err)
+		}
+	}
+
+	result := &Result{
+		Run:        current,
+		Score:      current.State.CurrentScore,
+		FinalBlind: current.State.CurrentBlind,
+		JokersUsed: []string{},
+	}
+	for _, joker := range current.State.Jokers {
+		result.JokersUsed = append(result.JokersUsed, joker.ID)
+	}
+	return result, nil
+}
+
+// Verify kaydı yeniden oynatır ve gönderilen skor ile blind'ı karşılaştırır
+func Verify(setup Setup, actions []models.ReplayAction, score int64, finalBlind int) Verdict {
+	result, err := Simulate(setup, actions)
+	if err != nil {
+		reason := ReasonInvalidReplay
+		if errors.Is(err, ErrMissingReplay) {
+			reason = ReasonMissingReplay
+		}
+		return Verdict{Reason: reason, Detail: err.Error()}
+	}
+
+	switch {
+	case result.Score != score:
+		return Verdict{
+			Reason: ReasonScoreMismatch,
+			Detail: fmt.Sprintf("gönderilen skor %d, hesaplanan %d", score, result.Score),
+			Result: result,
+		}
+	case result.FinalBlind != finalBlind:
+		return Verdict{
+			Reason: ReasonBlindMismatch,
+			Detail: fmt.Sprintf("gönderilen blind %d, hesaplanan %d", finalBlind, result.FinalBlind),
+			Result: result,
+		}
+	}
+	return Verdict{Accepted: true, Result: result}
+}
+
+// apply tek bir aksiyonu run'a uygular
+func apply(current *models.Run, action models.ReplayAction) error {
+	var err error
+	switch action.Type {
+	case ActionPlay:
+		_, err = run.Play(current, action.Cards)
+	case ActionDiscard:
+		_, err = run.Discard(current, action.Cards)
+	case ActionBuy:
+		_, err = run.Buy(current, action.Slot)
+	case ActionReroll:
+		err = run.Reroll(current)
+	case ActionOpenPack:
+		_, err = run.OpenPack(current, action.Index)
+	case ActionPickPack:
+		_, err = run.PickFromPack(current, action.Choices)
+	case ActionUseTarot:
+		err = run.UseTarot(current, action.ItemID, action.Targets)
+	case ActionAdvance:
+		err = run.Advance(current)
+	default:
+		err = ErrUnknownAction
+	}
+	return err
+}
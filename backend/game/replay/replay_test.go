@@ -0,0 +1,105 @@
+package replay
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"balatro-backend/game/run"
+	"balatro-backend/models"
+)
+
+// playthrough canlı bir run'ı basit bir stratejiyle oynatır ve aksiyon kaydını döndürür
+func playthrough(t *testing.T, setup Setup) (*models.Run, []models.ReplayAction) {
+	t.Helper()
+
+	live, err := run.New("", setup.Seed, setup.Deck, setup.Stake)
+	if err != nil {
+		t.Fatalf("run.New: %v", err)
+	}
+
+	var actions []models.ReplayAction
+	do := func(action models.ReplayAction) {
+		if err := apply(live, action); err != nil {
+			t.Fatalf("aksiyon %d (%s): %v", len(actions), action.Type, err)
+		}
+		actions = append(actions, action)
+	}
+
+	for i := 0; i < 60 && live.Phase != models.PhaseGameOver; i++ {
+		switch live.Phase {
+		case models.PhasePlaying:
+			if live.State.DiscardsLeft > 0 && i%3 == 0 {
+				do(models.ReplayAction{Type: ActionDiscard, Cards: []int{0, 1}})
+			} else {
+				do(models.ReplayAction{Type: ActionPlay, Cards: []int{0, 1, 2, 3, 4}})
+			}
+		case models.PhaseShop:
+			// Dükkan RNG'sini de kapsamak için ilk uygun jokeri al
+			for _, item := range live.Shop {
+				if item.Type == "joker" && !item.Sold && item.Price <= live.State.Money && len(live.State.Jokers) < run.MaxJokers {
+					do(models.ReplayAction{Type: ActionBuy, Slot: item.Slot})
+					break
+				}
+			}
+			do(models.ReplayAction{Type: ActionAdvance})
+		default:
+			do(models.ReplayAction{Type: ActionAdvance})
+		}
+	}
+	return live, actions
+}
+
+// normalize zamana bağlı alanları karşılaştırma için sıfırlar
+func normalize(current *models.Run) *models.Run {
+	copied := *current
+	copied.CreatedAt, copied.UpdatedAt = time.Time{}, time.Time{}
+	copied.State.LastPlayedTimestamp = time.Time{}
+	return &copied
+}
+
+func TestSimulateIsDeterministic(t *testing.T) {
+	setup := Setup{Seed: "DETERMINISM", Deck: "red", Stake: "white"}
+	live, actions := playthrough(t, setup)
+
+	first, err := Simulate(setup, actions)
+	if err != nil {
+		t.Fatalf("Simulate: %v", err)
+	}
+	second, err := Simulate(setup, actions)
+	if err != nil {
+		t.Fatalf("Simulate: %v", err)
+	}
+
+	if first.Score != second.Score || first.FinalBlind != second.FinalBlind {
+		t.Fatalf("replays differ: %d/%d vs %d/%d", first.Score, first.FinalBlind, second.Score, second.FinalBlind)
+	}
+	if !reflect.DeepEqual(normalize(first.Run), normalize(second.Run)) {
+		t.Fatal("replaying the same seed and actions produced different runs")
+	}
+	if !reflect.DeepEqual(normalize(first.Run), normalize(live)) {
+		t.Fatal("replay does not match the live run")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	setup := Setup{Seed: "VERIFY", Deck: "blue", Stake: "white"}
+	live, actions := playthrough(t, setup)
+	score, blind := live.State.CurrentScore, live.State.CurrentBlind
+
+	if verdict := Verify(setup, actions, score, blind); !verdict.Accepted {
+		t.Fatalf("honest submission rejected: %s %s", verdict.Reason, verdict.Detail)
+	}
+	if verdict := Verify(setup, actions, score+1, blind); verdict.Reason != ReasonScoreMismatch {
+		t.Errorf("inflated score: reason = %q, want %q", verdict.Reason, ReasonScoreMismatch)
+	}
+	if verdict := Verify(setup, actions, score, blind+1); verdict.Reason != ReasonBlindMismatch {
+		t.Errorf("inflated blind: reason = %q, want %q", verdict.Reason, ReasonBlindMismatch)
+	}
+	if verdict := Verify(Setup{Seed: "OTHER", Deck: "blue", Stake: "white"}, actions, score, blind); verdict.Accepted {
+		t.Error("submission verified against a different seed")
+	}
+	if verdict := Verify(Setup{}, nil, score, blind); verdict.Reason != ReasonMissingReplay {
+		t.Errorf("missing replay: reason = %q, want %q", verdict.Reason, ReasonMissingReplay)
+	}
+}
@@ -0,0 +1,202 @@
+package rng
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"balatro-backend/game/cards"
+	"balatro-backend/models"
+)
+
+// Version RNG algoritmasının sürümü. Üretilen diziler değişirse artırılmalıdır,
+// aksi halde eski seed'ler farklı desteler üretir.
+const Version = 1
+
+// Stream isimleri: her kullanım alanı kendi bağımsız dizisini kullanır,
+// böylece örneğin dükkan yenilemek desteyi etkilemez
+const (
+	StreamDeck    = "deck"
+	StreamShop    = "shop"
+	StreamPack    = "pack"
+	StreamTarot   = "tarot"
+	StreamVoucher = "voucher"
+	StreamGlass   = "glass"
+	StreamSeal    = "seal"
+)
+
+// seedAlphabet seed karakterleri (karışabilecek 0/O ve 1/I hariç)
+const seedAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// SeedLength üretilen seed uzunluğu
+const SeedLength = 8
+
+// maxSeedLength kabul edilen en uzun seed
+const maxSeedLength = 32
+
+// ErrInvalidSeed seed formatı geçersizse döner
+var ErrInvalidSeed = errors.New("seed yalnızca harf ve rakamlardan oluşmalı (1-32 karakter)")
+
+// RNG seed'den türetilen deterministik rastgele sayı üreteci (splitmix64)
+type RNG struct {
+	state uint64
+}
+
+// New seed ve stream adından deterministik bir RNG oluşturur
+func New(seed, stream string) *RNG {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("v%d|%s|%s", Version, seed, stream)))
+	return &RNG{state: binary.BigEndian.Uint64(sum[:8])}
+}
+
+// Uint64 sonraki 64 bitlik değeri döndürür
+func (r *RNG) Uint64() uint64 {
+	r.state += 0x9E3779B97F4A7C15
+	z := r.state
+	z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+	z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+	return z ^ (z >> 31)
+}
+
+// Intn [0, n) aralığında eşit dağılımlı bir sayı döndürür
+func (r *RNG) Intn(n int) int {
+	if n <= 0 {
+		panic("rng: Intn için n pozitif olmalı")
+	}
+	// Modulo yanlılığını önlemek için reddetme örneklemesi
+	limit := ^uint64(0) - (^uint64(0) % uint64(n))
+	for {
+		v := r.Uint64()
+		if v < limit {
+			return int(v % uint64(n))
+		}
+	}
+}
+
+// Float64 [0, 1) aralığında bir sayı döndürür
+func (r *RNG) Float64() float64 {
+	return float64(r.Uint64()>>11) / (1 << 53)
+}
+
+// Chance 1/n olasılıkla true döndürür
+func (r *RNG) Chance(n int) bool {
+	return r.Intn(n) == 0
+}
+
+// WeightedIndex ağırlıklara göre bir indeks seçer
+func (r *RNG) WeightedIndex(weights []int) int {
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+	if total <= 0 {
+		return 0
+	}
+	pick := r.Intn(total)
+	for i, w := range weights {
+		if pick < w {
+			return i
+		}
+		pick -= w
+	}
+	return len(weights) - 1
+}
+
+// Shuffle Fisher-Yates algoritmasıyla n elemanı karıştırır
+func (r *RNG) Shuffle(n int, swap func(i, j int)) {
+	for i := n - 1; i > 0; i-- {
+		j := r.Intn(i + 1)
+		swap(i, j)
+	}
+}
+
+// NormalizeSeed seed'i büyük harfe çevirir ve formatını doğrular
+func NormalizeSeed(seed string) (string, error) {
+	seed = strings.ToUpper(strings.TrimSpace(seed))
+	if seed == "" || len(seed) > maxSeedLength {
+		return "", ErrInvalidSeed
+	}
+	for _, ch := range seed {
+		if !(ch >= 'A' && ch <= 'Z') && !(ch >= '0' && ch <= '9') {
+			return "", ErrInvalidSeed
+		}
+	}
+	return seed, nil
+}
+
+// NewSeed yeni rastgele bir seed üretir
+func NewSeed() string {
+	var sb strings.Builder
+	max := big.NewInt(int64(len(seedAlphabet)))
+	for i := 0; i < SeedLength; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			panic(fmt.Sprintf("rng: seed üretilemedi: %v", err))
+		}
+		sb.WriteByte(seedAlphabet[n.Int64()])
+	}
+	return sb.String()
+}
+
+// ForDeck belirli bir turun deste karıştırması için RNG döndürür
+func ForDeck(seed string, round int) *RNG {
+	return New(seed, fmt.Sprintf("%s:%d", StreamDeck, round))
+}
+
+// ForShop belirli bir ante'deki dükkan ziyareti ve yenileme için RNG döndürür
+func ForShop(seed string, ante, visit, reroll int) *RNG {
+	return New(seed, fmt.Sprintf("%s:%d:%d:%d", StreamShop, ante, visit, reroll))
+}
+
+// ForPack belirli bir ante'de açılan n. paket için RNG döndürür
+func ForPack(seed string, ante, index int) *RNG {
+	return New(seed, fmt.Sprintf("%s:%d:%d", StreamPack, ante, index))
+}
+
+// ForTarot seed ile kullanılan n. tarot kartı için RNG döndürür
+func ForTarot(seed string, index int) *RNG {
+	return New(seed, fmt.Sprintf("%s:%d", StreamTarot, index))
+}
+
+// ForVoucher belirli bir ante'de dükkanda sunulan voucher için RNG döndürür
+func ForVoucher(seed string, ante int) *RNG {
+	return New(seed, fmt.Sprintf("%s:%d", StreamVoucher, ante))
+}
+
+// ForGlass bir turda oynanan n. elin Glass kırılma zarları için RNG döndürür
+func ForGlass(seed string, round, hand int) *RNG {
+	return New(seed, fmt.Sprintf("%s:%d:%d", StreamGlass, round, hand))
+}
+
+// ForSeal bir turda yapılan n. discard'ın seal etkileri için RNG döndürür
+func ForSeal(seed string, round, discard int) *RNG {
+	return New(seed, fmt.Sprintf("%s:%d:%d", StreamSeal, round, discard))
+}
+
+// ShuffleCards kartların karıştırılmış bir kopyasını döndürür
+func (r *RNG) ShuffleCards(deck []models.Card) []models.Card {
+	shuffled := make([]models.Card, len(deck))
+	copy(shuffled, deck)
+	r.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled
+}
+
+// Deck seed ve tur için 52'lik standart destenin karıştırılmış sırasını döndürür
+func Deck(seed string, round int) []models.Card {
+	return ForDeck(seed, round).ShuffleCards(cards.NewStandardDeck())
+}
+
+// Draw destenin başından n kart çeker, çekilen kartları ve kalan desteyi döndürür
+func Draw(deck []models.Card, n int) ([]models.Card, []models.Card) {
+	if n > len(deck) {
+		n = len(deck)
+	}
+	drawn := make([]models.Card, n)
+	copy(drawn, deck[:n])
+	return drawn, deck[n:]
+}
@@ -0,0 +1,39 @@
+package rng
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Bu değerler değişirse eski seed'ler farklı oyunlar üretir; Version artırılmalıdır
+func TestSequenceIsStable(t *testing.T) {
+	r := New("SEED1234", StreamDeck)
+	want := []uint64{0xa5b6b4c5c4f7ffcf, 0xfa48368f04b90af3, 0xbb365f698d01a2cf}
+	for i, w := range want {
+		if got := r.Uint64(); got != w {
+			t.Fatalf("value %d = %#x, want %#x", i, got, w)
+		}
+	}
+}
+
+func TestDeckIsDeterministic(t *testing.T) {
+	first, second := Deck("SEED1234", 1), Deck("SEED1234", 1)
+	if !reflect.DeepEqual(first, second) {
+		t.Fatal("same seed and round produced different decks")
+	}
+	if first[0].Suit != "DIAMONDS" || first[0].Value != "8" {
+		t.Errorf("first card = %s %s, want DIAMONDS 8", first[0].Suit, first[0].Value)
+	}
+	if reflect.DeepEqual(first, Deck("SEED1234", 2)) {
+		t.Error("different rounds produced the same deck")
+	}
+	if reflect.DeepEqual(first, Deck("SEED4321", 1)) {
+		t.Error("different seeds produced the same deck")
+	}
+}
+
+func TestStreamsAreIndependent(t *testing.T) {
+	if New("SEED1234", StreamDeck).Uint64() == New("SEED1234", StreamShop).Uint64() {
+		t.Error("deck and shop streams share a sequence")
+	}
+}
@@ -0,0 +1,67 @@
+package run
+
+import (
+	"balatro-backend/game/blinds"
+	"balatro-backend/game/cards"
+	"balatro-backend/game/jokers"
+	"balatro-backend/models"
+)
+
+// Ödeme kalemi türleri
+const (
+	CashOutBlind    = "blind"     // Blind tamamlama ödülü
+	CashOutHands    = "hands"     // Kullanılmayan eller
+	CashOutInterest = "interest"  // Eldeki paranın faizi
+	CashOutGoldCard = "gold_card" // Elde tutulan Gold kartlar
+	CashOutJoker    = "joker"     // Blind sonu joker parası
+)
+
+// MoneyPerUnusedHand blind sonunda kullanılmayan her el için ödenen para
+const MoneyPerUnusedHand = 1
+
+// cashOut blind sonu ödemesini kalem kalem hesaplar. Faiz ödemeden önceki
+// paraya göre hesaplanır; blind sonu jokerlerinin istatistikleri güncellenir.
+func cashOut(run *models.Run) *models.CashOut {
+	result := &models.CashOut{Items: []models.CashOutItem{}}
+	add := func(item models.CashOutItem) {
+		if item.Money == 0 {
+			return
+		}
+		result.Items = append(result.Items, item)
+		result.Total += item.Money
+	}
+
+	add(models.CashOutItem{
+		Kind:  CashOutBlind,
+		Money: blinds.Reward(blinds.Kind(run.State.BlindKind), Stake(run)),
+	})
+	add(models.CashOutItem{
+		Kind:  CashOutHands,
+		Count: run.State.HandsLeft,
+		Money: run.State.HandsLeft * MoneyPerUnusedHand,
+	})
+	add(models.CashOutItem{Kind: CashOutInterest, Money: Interest(run)})
+
+	for _, card := range run.State.HandCards {
+		if cards.HasEnhancement(card, cards.Gold) {
+			add(models.CashOutItem{
+				Kind:   CashOutGoldCard,
+				Source: card.Value + " " + card.Suit,
+				Money:  cards.GoldHeldMoney,
+			})
+		}
+	}
+
+	ctx := jokers.Context{Held: run.State.HandCards, NoLivesLost: true}
+	for i := range run.State.Jokers {
+		joker := &run.State.Jokers[i]
+		effect := jokers.Evaluate(*joker, jokers.OnRoundEnd, ctx)
+		if effect.IsZero() {
+			continue
+		}
+		jokers.RecordTrigger(joker, effect)
+		add(models.CashOutItem{Kind: CashOutJoker, Source: joker.ID, Money: effect.Money})
+	}
+
+	return result
+}
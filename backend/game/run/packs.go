@@ -0,0 +1,101 @@
+package run
+
+import (
+	"fmt"
+
+	"balatro-backend/game/packs"
+	"balatro-backend/game/planets"
+	"balatro-backend/game/rng"
+	"balatro-backend/game/tarots"
+	"balatro-backend/models"
+)
+
+// OpenPack satın alınmış paketi açar ve içeriğini run seed'inden üretir
+func OpenPack(run *models.Run, index int) (*models.OpenPack, error) {
+	if run.Phase != models.PhaseShop {
+		return nil, ErrWrongPhase
+	}
+	if run.OpenPack != nil {
+		return nil, ErrPackOpen
+	}
+	if index < 0 || index >= len(run.Packs) {
+		return nil, fmt.Errorf("%w: paket %d yok", ErrItemNotAvailable, index)
+	}
+
+	def, ok := packs.Get(run.Packs[index])
+	if !ok {
+		return nil, fmt.Errorf("%w: bilinmeyen paket %s", ErrItemNotAvailable, run.Packs[index])
+	}
+
+	r := rng.ForPack(run.Seed, Ante(run), run.PacksOpened)
+	run.PacksOpened++
+	run.Packs = append(run.Packs[:index], run.Packs[index+1:]...)
+	run.OpenPack = &models.OpenPack{
+		PackID:    def.ID,
+		Offers:    packs.Roll(def, r),
+		PicksLeft: def.Picks,
+	}
+	return run.OpenPack, nil
+}
+
+// PickFromPack açık paketten seçilen teklifleri duruma ekler ve paketi kapatır.
+// Boş seçim paketi atlar.
+func PickFromPack(run *models.Run, choices []int) ([]models.PackOffer, error) {
+	pack := run.OpenPack
+	if pack == nil {
+		return nil, ErrNoPackOpen
+	}
+	if len(choices) > pack.PicksLeft {
+		return nil, fmt.Errorf("%w: en fazla %d seçim yapılabilir", ErrInvalidSelection, pack.PicksLeft)
+	}
+
+	chosen := map[int]bool{}
+	for _, i := range choices {
+		if i < 0 || i >= len(pack.Offers) {
+			return nil, fmt.Errorf("%w: teklif %d yok", ErrInvalidSelection, i)
+		}
+		if chosen[i] {
+			return nil, fmt.Errorf("%w: teklif %d birden fazla seçildi", ErrInvalidSelection, i)
+		}
+		chosen[i] = true
+	}
+
+	// Tüm seçimleri kopya üzerinde uygula; hata olursa durum değişmez
+	state := run.State
+	state.TarotCardsInventory = append([]models.TarotCard{}, run.State.TarotCardsInventory...)
+	state.PlanetLevels = copyLevels(run.State.PlanetLevels)
+	deck := append([]models.Card{}, run.Deck...)
+
+	var picked []models.PackOffer
+	for _, i := range choices {
+		offer := pack.Offers[i]
+		switch offer.Type {
+		case packs.OfferTarot:
+			tarots.AddToInventory(&state, offer.ItemID)
+		case packs.OfferPlanet:
+			if _, err := planets.Use(&state, offer.ItemID); err != nil {
+				return nil, err
+			}
+		case packs.OfferCard:
+			deck = append(deck, *offer.Card)
+		default:
+			return nil, fmt.Errorf("%w: bilinmeyen teklif tipi %s", ErrItemNotAvailable, offer.Type)
+		}
+		offer.Picked = true
+		picked = append(picked, offer)
+	}
+
+	run.State = state
+	run.Deck = deck
+	run.OpenPack = nil
+	return picked, nil
+}
+
+// copyLevels planet seviyelerinin kopyasını döndürür
+func copyLevels(levels map[string]int) map[string]int {
+	result := make(map[string]int, len(levels))
+	for hand, level := range levels {
+		result[hand] = level
+	}
+	return result
+}
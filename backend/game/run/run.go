@@ -0,0 +1,588 @@
+package run
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"balatro-backend/game/blinds"
+	"balatro-backend/game/cards"
+	"balatro-backend/game/decks"
+	"balatro-backend/game/hands"
+	"balatro-backend/game/jokers"
+	"balatro-backend/game/planets"
+	"balatro-backend/game/rng"
+	"balatro-backend/game/scoring"
+	"balatro-backend/game/shop"
+	"balatro-backend/game/stakes"
+	"balatro-backend/game/tarots"
+	"balatro-backend/game/unlocks"
+	"balatro-backend/game/vouchers"
+	"balatro-backend/migrations"
+	"balatro-backend/models"
+)
+
+// Başlangıç değerleri ve kurallar (frontend GameScene ile aynı)
+const (
+	StartingMoney    = 50
+	StartingLives    = 3
+	StartingHands    = 4
+	StartingDiscards = 3
+	HandSize         = 8 // Eldeki kart sayısı
+	MaxSelection     = 5 // Tek seferde oynanabilecek/discard edilebilecek kart sayısı
+	MaxJokers        = 5 // Joker slot sayısı
+	InterestStep     = 5 // Her $5 için $1 faiz
+	WinningAnte      = 8 // Bu ante'nin boss blind'ını geçmek run'ı kazandırır
+)
+
+// Run kural hataları
+var (
+	ErrWrongPhase       = errors.New("bu işlem mevcut fazda yapılamaz")
+	ErrNoHandsLeft      = errors.New("el hakkı kalmadı")
+	ErrNoDiscardsLeft   = errors.New("discard hakkı kalmadı")
+	ErrInvalidSelection = errors.New("geçersiz kart seçimi")
+	ErrNotEnoughMoney   = errors.New("yetersiz para")
+	ErrJokerSlotsFull   = errors.New("joker alanı dolu")
+	ErrItemNotAvailable = errors.New("dükkan öğesi mevcut değil")
+	ErrPackOpen         = errors.New("önce açık paketten seçim yapılmalı")
+	ErrNoPackOpen       = errors.New("açık paket yok")
+)
+
+// PlayOutcome el oynamanın sonucu
+type PlayOutcome struct {
+	Score        *scoring.Result `json:"score"`
+	Nullified    bool            `json:"nullified"` // Boss blind eli sıfırladı mı
+	BlindCleared bool            `json:"blindCleared"`
+	LifeLost     bool            `json:"lifeLost"`
+	GameOver     bool            `json:"gameOver"`
+	Won          bool            `json:"won"`                 // Kazanma ante'sinin boss blind'ı geçildi mi
+	CashOut      *models.CashOut `json:"cashOut,omitempty"`   // Blind geçildiyse ödeme dökümü
+	Shattered    []models.Card   `json:"shattered,omitempty"` // Kırılıp desteden çıkan Glass kartlar
+}
+
+// New yeni bir run oluşturur; seed boşsa rastgele üretilir, deste ve stake boşsa
+// varsayılanları kullanılır
+func New(userID, seed, deckID, stakeID string) (*models.Run, error) {
+	generated := seed == ""
+	if generated {
+		seed = rng.NewSeed()
+	}
+	seed, err := rng.NormalizeSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+	deck, ok := decks.Get(deckID)
+	if !ok {
+		return nil, decks.ErrUnknownDeck
+	}
+	stake, ok := stakes.Get(stakeID)
+	if !ok {
+		return nil, stakes.ErrUnknownStake
+	}
+	discards := StartingDiscards + deck.ExtraDiscards - stakes.Combined(stake.ID).DiscardPenalty
+	if discards < 0 {
+		discards = 0
+	}
+
+	now := time.Now()
+	return &models.Run{
+		UserID:        userID,
+		Seed:          seed,
+		SeedGenerated: generated,
+		DeckID:        deck.ID,
+		StakeID:       stake.ID,
+		RNGVersion:    rng.Version,
+		Phase:         models.PhaseBlindSelect,
+		Deck:          deck.Cards(),
+		State: models.PlayerState{
+			UserID:              userID,
+			SchemaVersion:       migrations.PlayerStateVersion,
+			CurrentBlind:        1,
+			CurrentAnte:         1,
+			BlindKind:           string(blinds.Small),
+			BossBlindID:         blinds.BossForAnte(seed, 1).ID,
+			Money:               StartingMoney + deck.ExtraMoney,
+			Lives:               StartingLives,
+			HandsLeft:           StartingHands + deck.ExtraHands,
+			DiscardsLeft:        discards,
+			DeckCards:           []models.Card{},
+			HandCards:           []models.Card{},
+			Jokers:              []models.Joker{},
+			TarotCardsInventory: []models.TarotCard{},
+			PlanetLevels:        map[string]int{},
+			VouchersOwned:       []string{},
+			UnlockedContent:     map[string][]string{},
+			LastPlayedTimestamp: now,
+		},
+		Shop:       []models.ShopItem{},
+		Packs:      []string{},
+		HandCounts: map[string]int{},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// Ante mevcut ante'yi döndürür
+func Ante(run *models.Run) int {
+	return run.State.CurrentAnte
+}
+
+// Stake run'ın aktif stake'inin birleşik etkilerini döndürür
+func Stake(run *models.Run) stakes.Modifiers {
+	return stakes.Combined(run.StakeID)
+}
+
+// Boss ante'nin boss blind'ını döndürür
+func Boss(run *models.Run) *blinds.BossBlind {
+	boss, ok := blinds.GetBoss(run.State.BossBlindID)
+	if !ok {
+		return nil
+	}
+	return boss
+}
+
+// activeBoss oynanan blind bir boss blind ise kurallarını döndürür
+func activeBoss(run *models.Run) *blinds.BossBlind {
+	if blinds.Kind(run.State.BlindKind) != blinds.Boss {
+		return nil
+	}
+	return Boss(run)
+}
+
+// CurrentBlind mevcut (veya sıradaki) blind'ın hedef ve ödül bilgilerini döndürür
+func CurrentBlind(run *models.Run) blinds.Blind {
+	kind := blinds.Kind(run.State.BlindKind)
+	blind := blinds.Blind{
+		Ante:   Ante(run),
+		Kind:   kind,
+		Target: blinds.Target(Ante(run), kind, Boss(run), Stake(run)),
+		Reward: blinds.Reward(kind, Stake(run)),
+	}
+	if kind == blinds.Boss {
+		blind.Boss = Boss(run)
+	}
+	return blind
+}
+
+// BlindTarget mevcut blind'ın hedef skorunu döndürür
+func BlindTarget(run *models.Run) int64 {
+	return CurrentBlind(run).Target
+}
+
+// Advance run'ı bir sonraki faza geçirir: dükkandan blind seçimine,
+// blind seçiminden blind'ın oynanmasına (kartlar dağıtılır)
+func Advance(run *models.Run) error {
+	switch run.Phase {
+	case models.PhaseShop:
+		if run.OpenPack != nil {
+			return ErrPackOpen
+		}
+		run.Phase = models.PhaseBlindSelect
+		run.Shop = []models.ShopItem{}
+		return nil
+	case models.PhaseBlindSelect:
+		startBlind(run)
+		return nil
+	default:
+		return ErrWrongPhase
+	}
+}
+
+// startBlind desteyi karıştırır, el ve discard haklarını sıfırlar ve eli dağıtır
+func startBlind(run *models.Run) {
+	run.Round++
+	run.RoundScore = 0
+	run.HandsPlayed = 0
+	run.Phase = models.PhasePlaying
+
+	// Deste, pasif joker (örn. Juggler +1 discard) ve voucher bonusları
+	run.State.HandsLeft, run.State.DiscardsLeft = StartingHands, StartingDiscards
+	if deck, ok := decks.Get(run.DeckID); ok {
+		run.State.HandsLeft += deck.ExtraHands
+		run.State.DiscardsLeft += deck.ExtraDiscards
+	}
+	passive := jokers.Collect(run.State.Jokers, jokers.Passive, jokers.Context{})
+	owned := vouchers.Combined(run.State.VouchersOwned)
+	run.State.HandsLeft += passive.ExtraHands + owned.ExtraHands
+	run.State.DiscardsLeft += passive.ExtraDiscards + owned.ExtraDiscards - Stake(run).DiscardPenalty
+	if run.State.DiscardsLeft < 0 {
+		run.State.DiscardsLeft = 0
+	}
+
+	// Boss blind kısıtlamaları
+	if boss := activeBoss(run); boss != nil {
+		if boss.MaxHands > 0 && run.State.HandsLeft > boss.MaxHands {
+			run.State.HandsLeft = boss.MaxHands
+		}
+		if boss.NoDiscards {
+			run.State.DiscardsLeft = 0
+		}
+	}
+
+	run.State.DeckCards = rng.ForDeck(run.Seed, run.Round).ShuffleCards(run.Deck)
+	run.State.HandCards = []models.Card{}
+	drawToHandSize(run)
+}
+
+// Play seçilen kartları oynar, puanı hesaplar ve blind durumunu günceller
+func Play(run *models.Run, indices []int) (*PlayOutcome, error) {
+	if run.Phase != models.PhasePlaying {
+		return nil, ErrWrongPhase
+	}
+	if run.State.HandsLeft <= 0 {
+		return nil, ErrNoHandsLeft
+	}
+
+	played, held, err := selectCards(run.State.HandCards, indices)
+	if err != nil {
+		return nil, err
+	}
+
+	// Puanı hesapla (joker istatistikleri run üzerinde güncellenir).
+	// Can kaybı blind'ı yeniden başlattığı için blind içinde her zaman true.
+	boss := activeBoss(run)
+	score, err := scoring.Calculate(scoring.Input{
+		Played:       played,
+		Held:         held,
+		Jokers:       run.State.Jokers,
+		PlanetLevels: run.State.PlanetLevels,
+		NoLivesLost:  true,
+		Debuffed:     boss.IsDebuffed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	outcome := &PlayOutcome{Score: score}
+	if boss != nil && boss.FirstHandScoresZero && run.HandsPlayed == 0 {
+		score.Total = 0
+		outcome.Nullified = true
+	}
+
+	// Glass kartlar puana katıldıktan sonra kırılabilir
+	glass := rng.ForGlass(run.Seed, run.Round, run.HandsPlayed)
+	for _, i := range score.GlassCards {
+		if glass.Chance(cards.GlassShatterOdds) {
+			removeFromDeck(run, played[i])
+			outcome.Shattered = append(outcome.Shattered, played[i])
+		}
+	}
+
+	if run.HandCounts == nil {
+		run.HandCounts = map[string]int{}
+	}
+	run.HandCounts[score.Hand.Name]++
+	run.HandsPlayed++
+	run.RoundScore += score.Total
+	run.State.CurrentScore += score.Total
+	run.State.Money += score.Money
+	run.State.HandsLeft--
+	run.State.HandCards = held
+	drawToHandSize(run)
+
+	switch {
+	case run.RoundScore >= BlindTarget(run):
+		outcome.BlindCleared = true
+		outcome.Won = Ante(run) == WinningAnte && blinds.Kind(run.State.BlindKind) == blinds.Boss
+		completeBlind(run, score.Hand.Type)
+		outcome.CashOut = run.CashOut
+	case run.State.HandsLeft <= 0:
+		outcome.LifeLost = true
+		outcome.GameOver = loseLife(run)
+	}
+
+	return outcome, nil
+}
+
+// Discard seçilen kartları atar ve yerine yenilerini çeker
+func Discard(run *models.Run, indices []int) ([]models.Card, error) {
+	if run.Phase != models.PhasePlaying {
+		return nil, ErrWrongPhase
+	}
+	if run.State.DiscardsLeft <= 0 {
+		return nil, ErrNoDiscardsLeft
+	}
+
+	discarded, held, err := selectCards(run.State.HandCards, indices)
+	if err != nil {
+		return nil, err
+	}
+
+	// Discard jokerleri
+	effect := jokers.Collect(run.State.Jokers, jokers.OnDiscard, jokers.Context{
+		Held:      held,
+		Discarded: discarded,
+	})
+	run.State.Money += effect.Money
+
+	// Purple seal'lı kartlar rastgele bir tarot verir
+	seal := rng.ForSeal(run.Seed, run.Round, run.State.DiscardsLeft)
+	for _, card := range discarded {
+		if card.Seal == cards.PurpleSeal {
+			all := tarots.All()
+			tarots.AddToInventory(&run.State, all[seal.Intn(len(all))].ID)
+		}
+	}
+
+	run.State.DiscardsLeft--
+	run.State.HandCards = held
+	drawToHandSize(run)
+
+	return discarded, nil
+}
+
+// Buy dükkandaki bir öğeyi satın alır. Joker slota, tarot envantere, paket
+// açılmamış paketlere eklenir; planet hemen kullanılır. Para yalnızca öğe
+// eklenebildiyse düşülür.
+func Buy(run *models.Run, slot int) (*models.ShopItem, error) {
+	if run.Phase != models.PhaseShop {
+		return nil, ErrWrongPhase
+	}
+
+	var item *models.ShopItem
+	for i := range run.Shop {
+		if run.Shop[i].Slot == slot && !run.Shop[i].Sold {
+			item = &run.Shop[i]
+			break
+		}
+	}
+	if item == nil {
+		return nil, ErrItemNotAvailable
+	}
+	if run.State.Money < item.Price {
+		return nil, ErrNotEnoughMoney
+	}
+
+	switch item.Type {
+	case shop.ItemJoker:
+		if len(run.State.Jokers) >= MaxJokers {
+			return nil, ErrJokerSlotsFull
+		}
+		run.State.Jokers = append(run.State.Jokers, models.Joker{
+			ID:       item.ItemID,
+			Level:    1,
+			IsActive: true,
+			Stats:    map[string]interface{}{},
+			Eternal:  item.Eternal,
+		})
+	case shop.ItemTarot:
+		tarots.AddToInventory(&run.State, item.ItemID)
+	case shop.ItemPlanet:
+		if _, err := planets.Use(&run.State, item.ItemID); err != nil {
+			return nil, err
+		}
+	case shop.ItemPack:
+		run.Packs = append(run.Packs, item.ItemID)
+	case shop.ItemVoucher:
+		if run.VoucherAnte == Ante(run) || vouchers.Owns(run.State.VouchersOwned, item.ItemID) {
+			return nil, fmt.Errorf("%w: bu ante'de zaten voucher alındı", ErrItemNotAvailable)
+		}
+		run.State.VouchersOwned = append(run.State.VouchersOwned, item.ItemID)
+		run.VoucherAnte = Ante(run)
+	default:
+		return nil, fmt.Errorf("%w: bilinmeyen öğe tipi %s", ErrItemNotAvailable, item.Type)
+	}
+
+	run.State.Money -= item.Price
+	item.Sold = true
+	return item, nil
+}
+
+// UseTarot envanterdeki tarot kartını run seed'inden türetilen RNG ile kullanır
+func UseTarot(run *models.Run, id string, targets []int) error {
+	if run.Phase == models.PhaseGameOver {
+		return ErrWrongPhase
+	}
+
+	// Hata durumunda run değişmesin diye kopya üzerinde uygula
+	state := run.State
+	state.HandCards = copyCards(run.State.HandCards)
+	state.Jokers = append([]models.Joker{}, run.State.Jokers...)
+	state.TarotCardsInventory = append([]models.TarotCard{}, run.State.TarotCardsInventory...)
+
+	if err := tarots.Use(&state, id, targets, rng.ForTarot(run.Seed, run.TarotsUsed)); err != nil {
+		return err
+	}
+	run.State = state
+	run.TarotsUsed++
+	return nil
+}
+
+// Reroll ücreti ödeyerek dükkanı yeniler; her reroll'da ücret artar
+func Reroll(run *models.Run) error {
+	if run.Phase != models.PhaseShop {
+		return ErrWrongPhase
+	}
+	if run.State.Money < run.RerollCost {
+		return ErrNotEnoughMoney
+	}
+
+	run.State.Money -= run.RerollCost
+	run.Rerolls++
+	run.RerollCost = rerollCost(run)
+
+	// Satılmamış voucher yeni dükkanda da kalır
+	run.Shop = shop.Generate(run.Seed, Ante(run), run.ShopVisit, run.Rerolls, shopOptions(run))
+	return nil
+}
+
+// completeBlind blind'ı tamamlar, ödemeyi yapar, elde kalan kartların etkilerini
+// uygular, sıradaki blind'a geçer ve dükkanı açar
+func completeBlind(run *models.Run, lastHand hands.HandType) {
+	run.CashOut = cashOut(run)
+	run.State.Money += run.CashOut.Total
+
+	// Blue seal'lı kartlar son oynanan elin planet'ini kullanır
+	for _, card := range run.State.HandCards {
+		if card.Seal == cards.BlueSeal {
+			if planet, ok := planets.ForHand(lastHand); ok {
+				planets.Use(&run.State, planet.ID)
+			}
+		}
+	}
+	run.State.CurrentBlind++
+
+	ante, kind := blinds.Next(run.State.CurrentAnte, blinds.Kind(run.State.BlindKind))
+	if ante != run.State.CurrentAnte {
+		run.State.BossBlindID = blinds.BossForAnte(run.Seed, ante).ID
+	}
+	run.State.CurrentAnte = ante
+	run.State.BlindKind = string(kind)
+
+	run.State.HandCards = []models.Card{}
+	run.State.DeckCards = []models.Card{}
+	run.Phase = models.PhaseShop
+	openShop(run)
+}
+
+// openShop blind sonrası dükkanı run seed'inden deterministik olarak oluşturur
+func openShop(run *models.Run) {
+	run.ShopVisit = run.State.CurrentBlind
+	run.Rerolls = 0
+	run.RerollCost = rerollCost(run)
+	run.Shop = shop.Generate(run.Seed, Ante(run), run.ShopVisit, 0, shopOptions(run))
+}
+
+// shopOptions sahip olunan voucher'lara göre dükkan ayarlarını döndürür.
+// Ante'de henüz voucher alınmadıysa ante'nin voucher'ı sunulur. Run başında
+// kilitli olan jokerler ve voucher'lar dükkanda sunulmaz.
+func shopOptions(run *models.Run) shop.Options {
+	opts := shop.Options{
+		Slots:         shop.DefaultSlots + vouchers.Combined(run.State.VouchersOwned).ExtraShopSlots,
+		EternalJokers: Stake(run).EternalJokers,
+		LockedJokers:  run.Locked[unlocks.CategoryJokers],
+	}
+	if run.VoucherAnte != Ante(run) {
+		opts.Voucher = shop.VoucherForAnte(run.Seed, Ante(run), run.State.VouchersOwned, run.Locked[unlocks.CategoryVouchers])
+	}
+	return opts
+}
+
+// rerollCost sıradaki reroll ücretini voucher indirimiyle döndürür
+func rerollCost(run *models.Run) int {
+	return shop.RerollCost(run.Rerolls, vouchers.Combined(run.State.VouchersOwned).RerollDiscount)
+}
+
+// Interest paraya göre faizi döndürür: her $5 için $1; üst sınır voucher'larla
+// artar, stake ile azalır
+func Interest(run *models.Run) int {
+	if run.State.Money <= 0 {
+		return 0
+	}
+	limit := vouchers.InterestCap(run.State.VouchersOwned) - Stake(run).InterestCapPenalty
+	if limit < 0 {
+		limit = 0
+	}
+	interest := run.State.Money / InterestStep
+	if interest > limit {
+		interest = limit
+	}
+	return interest
+}
+
+// loseLife bir can düşürür; can kalmadıysa oyunu bitirir, kaldıysa blind'ı
+// yeniden başlatır. Oyun bittiyse true döner.
+func loseLife(run *models.Run) bool {
+	run.State.Lives--
+	if run.State.Lives <= 0 {
+		run.Phase = models.PhaseGameOver
+		return true
+	}
+	startBlind(run)
+	return false
+}
+
+// drawToHandSize eli desteden HandSize'a kadar doldurur
+func drawToHandSize(run *models.Run) {
+	missing := HandSize - len(run.State.HandCards)
+	if missing <= 0 {
+		return
+	}
+	drawn, rest := rng.Draw(run.State.DeckCards, missing)
+	run.State.HandCards = append(run.State.HandCards, drawn...)
+	run.State.DeckCards = rest
+}
+
+// removeFromDeck kartın bir kopyasını oyuncunun destesinden çıkarır
+func removeFromDeck(run *models.Run, card models.Card) {
+	for i := range run.Deck {
+		if sameCard(run.Deck[i], card) {
+			run.Deck = append(run.Deck[:i], run.Deck[i+1:]...)
+			return
+		}
+	}
+}
+
+// sameCard iki kartın tüm alanlarıyla aynı olup olmadığını kontrol eder
+func sameCard(a, b models.Card) bool {
+	if a.Suit != b.Suit || a.Value != b.Value || a.Edition != b.Edition || a.Seal != b.Seal ||
+		len(a.Enhancements) != len(b.Enhancements) {
+		return false
+	}
+	for i := range a.Enhancements {
+		if a.Enhancements[i] != b.Enhancements[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// copyCards kartların enhancement listeleriyle birlikte kopyasını döndürür
+func copyCards(list []models.Card) []models.Card {
+	result := make([]models.Card, len(list))
+	for i, card := range list {
+		card.Enhancements = append([]models.Enhancement{}, card.Enhancements...)
+		result[i] = card
+	}
+	return result
+}
+
+// selectCards eldeki kartları seçilen indekslere göre ikiye ayırır
+func selectCards(hand []models.Card, indices []int) (selected, rest []models.Card, err error) {
+	if len(indices) == 0 || len(indices) > MaxSelection {
+		return nil, nil, fmt.Errorf("%w: 1-%d kart seçilmeli", ErrInvalidSelection, MaxSelection)
+	}
+
+	chosen := map[int]bool{}
+	for _, i := range indices {
+		if i < 0 || i >= len(hand) {
+			return nil, nil, fmt.Errorf("%w: indeks %d elde yok", ErrInvalidSelection, i)
+		}
+		if chosen[i] {
+			return nil, nil, fmt.Errorf("%w: indeks %d birden fazla seçildi", ErrInvalidSelection, i)
+		}
+		chosen[i] = true
+	}
+
+	// Seçilen kartlar seçim sırasıyla, kalanlar eldeki sırasıyla
+	for _, i := range indices {
+		selected = append(selected, hand[i])
+	}
+	rest = []models.Card{}
+	for i, card := range hand {
+		if !chosen[i] {
+			rest = append(rest, card)
+		}
+	}
+	return selected, rest, nil
+}
@@ -0,0 +1,237 @@
+package scoring
+
+import (
+	"math"
+
+	"balatro-backend/game/cards"
+	"balatro-backend/game/hands"
+	"balatro-backend/game/jokers"
+	"balatro-backend/game/planets"
+	"balatro-backend/models"
+)
+
+// Breakdown adım türleri
+const (
+	StepHand        = "hand"        // El türünün temel değerleri
+	StepPlanet      = "planet"      // Planet seviye bonusu
+	StepCard        = "card"        // Puana katılan kartın çipi
+	StepEnhancement = "enhancement" // Kart enhancement bonusu
+	StepEdition     = "edition"     // Kart edition bonusu
+	StepSeal        = "seal"        // Kart seal etkisi (para)
+	StepRetrigger   = "retrigger"   // Kartın yeniden tetiklenmesi (Red seal)
+	StepHeld        = "held"        // Elde tutulan kart etkisi
+	StepJoker       = "joker"       // Joker katkısı
+	StepDebuff      = "debuff"      // Boss blind tarafından debuff edilen kart
+)
+
+// Input puan hesaplama girdisi
+type Input struct {
+	Played       []models.Card  // Oynanan kartlar (oynanma sırasıyla)
+	Held         []models.Card  // Elde kalan kartlar
+	Jokers       []models.Joker // Oyuncunun jokerleri
+	PlanetLevels map[string]int // El adına göre planet seviyeleri
+	NoLivesLost  bool           // Bu turda can kaybedilmedi mi (Perfectionist için)
+
+	// Debuffed kartın boss blind tarafından etkisizleştirilip etkisizleştirilmediğini
+	// döndürür (nil ise hiçbir kart debuff edilmez)
+	Debuffed func(card models.Card) bool
+}
+
+// Step puan hesaplamasındaki tek bir adım
+type Step struct {
+	Kind       string  `json:"kind"`
+	Source     string  `json:"source"`              // Kart, enhancement veya joker adı
+	CardIndex  *int    `json:"cardIndex,omitempty"` // Oynanan/elde tutulan kartın indeksi
+	Chips      int     `json:"chips,omitempty"`     // Eklenen çip
+	Mult       float64 `json:"mult,omitempty"`      // Eklenen çarpan
+	XMult      float64 `json:"xMult,omitempty"`     // Uygulanan çarpım
+	Money      int     `json:"money,omitempty"`     // Kazanılan para
+	ChipsAfter int64   `json:"chipsAfter"`          // Adım sonrası toplam çip
+	MultAfter  float64 `json:"multAfter"`           // Adım sonrası toplam çarpan
+}
+
+// Result puan hesaplama sonucu
+type Result struct {
+	Hand       *hands.Result `json:"hand"`
+	Chips      int64         `json:"chips"`
+	Multiplier float64       `json:"multiplier"`
+	Total      int64         `json:"total"`
+	Money      int           `json:"money"`                // Puanlama sırasında jokerlerden ve seal'lardan kazanılan para
+	GlassCards []int         `json:"glassCards,omitempty"` // Puana katılan Glass kartların oynanan eldeki indeksleri (kırılma zarı için)
+	Breakdown  []Step        `json:"breakdown"`
+}
+
+// calculator hesaplama sırasında toplamları ve adımları tutar
+type calculator struct {
+	chips     int64
+	mult      float64
+	money     int
+	breakdown []Step
+}
+
+func (c *calculator) add(kind, source string, cardIndex *int, chips int, mult float64) {
+	c.apply(kind, source, cardIndex, jokers.Effect{Chips: chips, Mult: mult})
+}
+
+func (c *calculator) apply(kind, source string, cardIndex *int, effect jokers.Effect) {
+	if effect.IsZero() && kind != StepHand && kind != StepDebuff && kind != StepRetrigger {
+		return
+	}
+	c.chips += int64(effect.Chips)
+	c.mult += effect.Mult
+	if effect.XMult != 0 {
+		c.mult *= effect.XMult
+	}
+	c.money += effect.Money
+	c.breakdown = append(c.breakdown, Step{
+		Kind:       kind,
+		Source:     source,
+		CardIndex:  cardIndex,
+		Chips:      effect.Chips,
+		Mult:       effect.Mult,
+		XMult:      effect.XMult,
+		Money:      effect.Money,
+		ChipsAfter: c.chips,
+		MultAfter:  c.mult,
+	})
+}
+
+// jokerRun bu eldeki joker tetiklenmelerini istatistikler için biriktirir
+type jokerRun struct {
+	jokers    []models.Joker
+	triggered []bool
+	totals    []jokers.Effect
+}
+
+// trigger belirli tetikleyicideki jokerleri sırayla çalıştırıp hesaplamaya uygular
+func (r *jokerRun) trigger(calc *calculator, trigger jokers.Trigger, ctx jokers.Context, cardIndex *int) {
+	for i, joker := range r.jokers {
+		def, ok := jokers.Get(joker.ID)
+		if !ok || !joker.IsActive || def.Trigger != trigger {
+			continue
+		}
+		effect := def.Effect(ctx)
+		r.triggered[i] = true
+		r.totals[i] = r.totals[i].Add(effect)
+		calc.apply(StepJoker, joker.ID, cardIndex, effect)
+	}
+}
+
+// Calculate oynanan el için çip × çarpan puanını adım adım hesaplar.
+// Tetiklenen jokerlerin Stats alanları input.Jokers üzerinde güncellenir.
+func Calculate(input Input) (*Result, error) {
+	handResult, err := hands.Evaluate(input.Played)
+	if err != nil {
+		return nil, err
+	}
+
+	calc := &calculator{}
+	run := &jokerRun{
+		jokers:    input.Jokers,
+		triggered: make([]bool, len(input.Jokers)),
+		totals:    make([]jokers.Effect, len(input.Jokers)),
+	}
+	ctx := jokers.Context{
+		Hand:        handResult,
+		Played:      input.Played,
+		Held:        input.Held,
+		NoLivesLost: input.NoLivesLost,
+	}
+
+	// Temel el değerleri
+	calc.add(StepHand, handResult.Name, nil, handResult.BaseChips, float64(handResult.BaseMultiplier))
+
+	// Planet seviye bonusu
+	if level := input.PlanetLevels[handResult.Name]; level > 0 {
+		chips, mult := planets.Bonus(handResult.Type, level)
+		calc.add(StepPlanet, handResult.Name, nil, chips, float64(mult))
+	}
+
+	// Puana katılan kartlar (Red seal'lı kartlar bir kez daha tetiklenir)
+	var glassCards []int
+	for i, card := range handResult.ScoringCards {
+		index := handResult.ScoringIndices[i]
+		name := card.Value + " " + card.Suit
+		if input.Debuffed != nil && input.Debuffed(card) {
+			calc.add(StepDebuff, name, &index, 0, 0)
+			continue
+		}
+
+		for trigger := 0; trigger <= cards.Retriggers(card); trigger++ {
+			if trigger > 0 {
+				calc.add(StepRetrigger, string(card.Seal), &index, 0, 0)
+			}
+			scoreCard(calc, card, name, &index)
+
+			// Kart bazlı jokerler (Stone kartların türü ve değeri yok sayılır)
+			if !cards.IsStone(card) {
+				cardCtx := ctx
+				cardCtx.Card = &handResult.ScoringCards[i]
+				run.trigger(calc, jokers.OnCardPlayed, cardCtx, &index)
+			}
+		}
+
+		if cards.HasEnhancement(card, cards.Glass) {
+			glassCards = append(glassCards, index)
+		}
+	}
+
+	// Elde tutulan kartlar: Steel çarpan verir, ardından elde tutma jokerleri
+	for i := range input.Held {
+		index := i
+		if input.Debuffed != nil && input.Debuffed(input.Held[i]) {
+			continue
+		}
+		if cards.HasEnhancement(input.Held[i], cards.Steel) {
+			calc.add(StepHeld, string(cards.Steel), &index, 0, cards.SteelHeldMult)
+		}
+		heldCtx := ctx
+		heldCtx.Card = &input.Held[i]
+		run.trigger(calc, jokers.OnHeld, heldCtx, &index)
+	}
+
+	// Bağımsız joker katkıları (joker sırasıyla)
+	for _, trigger := range []jokers.Trigger{jokers.OnPlay, jokers.OnHandPlayed, jokers.OnScoreCalc} {
+		run.trigger(calc, trigger, ctx, nil)
+	}
+
+	// Joker istatistiklerini güncelle (el başına bir tetiklenme)
+	for i := range input.Jokers {
+		if run.triggered[i] {
+			jokers.RecordTrigger(&input.Jokers[i], run.totals[i])
+		}
+	}
+
+	// Minimum çarpan 1 olmalı
+	multiplier := math.Max(1, calc.mult)
+
+	return &Result{
+		Hand:       handResult,
+		Chips:      calc.chips,
+		Multiplier: multiplier,
+		Total:      int64(math.Floor(float64(calc.chips) * multiplier)),
+		Money:      calc.money,
+		GlassCards: glassCards,
+		Breakdown:  calc.breakdown,
+	}, nil
+}
+
+// scoreCard puana katılan kartın çipini, enhancement, edition ve seal etkilerini uygular
+func scoreCard(calc *calculator, card models.Card, name string, index *int) {
+	if !cards.IsStone(card) {
+		calc.add(StepCard, name, index, cards.ChipValue(card.Value), 0)
+	}
+	for _, enhancement := range card.Enhancements {
+		bonus, ok := cards.EnhancementBonus(enhancement)
+		if !ok {
+			continue
+		}
+		calc.apply(StepEnhancement, string(enhancement), index, jokers.Effect{Chips: bonus.Chips, Mult: bonus.Mult, XMult: bonus.XMult})
+	}
+	if bonus, ok := cards.EditionBonus(card.Edition); ok {
+		calc.apply(StepEdition, string(card.Edition), index, jokers.Effect{Chips: bonus.Chips, Mult: bonus.Mult, XMult: bonus.XMult})
+	}
+	if card.Seal == cards.GoldSeal {
+		calc.apply(StepSeal, string(card.Seal), index, jokers.Effect{Money: cards.GoldSealMoney})
+	}
+}
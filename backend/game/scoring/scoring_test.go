@@ -0,0 +1,40 @@
+package scoring
+
+import (
+	"testing"
+
+	"balatro-backend/game/cards"
+	"balatro-backend/models"
+)
+
+func TestGlassMultipliesMult(t *testing.T) {
+	// Pair: 10 çip x2; iki As +22 çip, Multiplier1 +1 çarpan, Glass x2
+	played := []models.Card{
+		{Suit: cards.Spades, Value: cards.Ace, Enhancements: []models.Enhancement{cards.Multiplier1}},
+		{Suit: cards.Hearts, Value: cards.Ace, Enhancements: []models.Enhancement{cards.Glass}},
+	}
+	result, err := Calculate(Input{Played: played})
+	if err != nil {
+		t.Fatalf("Calculate: %v", err)
+	}
+
+	if result.Chips != 32 {
+		t.Errorf("chips = %d, want 32", result.Chips)
+	}
+	if result.Multiplier != 6 {
+		t.Errorf("multiplier = %v, want 6 ((2+1)x2)", result.Multiplier)
+	}
+
+	var glass *Step
+	for i := range result.Breakdown {
+		if result.Breakdown[i].Source == string(cards.Glass) {
+			glass = &result.Breakdown[i]
+		}
+	}
+	if glass == nil {
+		t.Fatal("no breakdown step for GLASS")
+	}
+	if glass.Kind != StepEnhancement || glass.XMult != 2 || glass.Mult != 0 {
+		t.Errorf("glass step = %+v, want enhancement x2 mult", *glass)
+	}
+}
@@ -0,0 +1,172 @@
+package shop
+
+import (
+	"balatro-backend/game/jokers"
+	"balatro-backend/game/packs"
+	"balatro-backend/game/planets"
+	"balatro-backend/game/rng"
+	"balatro-backend/game/tarots"
+	"balatro-backend/game/vouchers"
+	"balatro-backend/models"
+)
+
+// Dükkan öğe tipleri
+const (
+	ItemJoker   = "joker"
+	ItemTarot   = "tarot"
+	ItemPlanet  = "planet"
+	ItemPack    = "pack"
+	ItemVoucher = "voucher"
+)
+
+// Dükkan kuralları
+const (
+	DefaultSlots   = 5 // Dükkandaki öğe sayısı (frontend ShopScene ile aynı)
+	BaseRerollCost = 5 // İlk reroll ücreti
+	RerollIncrease = 1 // Her reroll'dan sonra ücret artışı
+	EternalChance  = 3 // Stake izin veriyorsa jokerin eternal olma olasılığı (1/n)
+)
+
+// weighted ağırlıklı seçim tablosu satırı
+type weighted struct {
+	value  string
+	weight int
+}
+
+// itemWeights öğe tipi ağırlıkları (frontend getRandomItemType ile aynı)
+var itemWeights = []weighted{
+	{ItemJoker, 40},
+	{ItemPack, 30},
+	{ItemTarot, 15},
+	{ItemPlanet, 15},
+}
+
+// rarityWeights joker nadirlik ağırlıkları (frontend createRandomJoker ile aynı)
+var rarityWeights = []weighted{
+	{jokers.Common, 60},
+	{jokers.Uncommon, 25},
+	{jokers.Rare, 12},
+	{jokers.Legendary, 3},
+}
+
+// Options dükkan üretimini etkileyen ayarlar
+type Options struct {
+	Slots         int                  // Öğe sayısı (0 ise DefaultSlots)
+	Voucher       *vouchers.Definition // Ayrı slotta sunulacak voucher (nil ise yok)
+	EternalJokers bool                 // Jokerler eternal olarak çıkabilir (stake etkisi)
+	LockedJokers  []string             // Profilde kilitli jokerler (sunulmaz)
+}
+
+// Generate dükkanı run seed'i, ante, ziyaret ve reroll sayısından deterministik olarak oluşturur.
+// Aynı girdiler her zaman aynı öğeleri ve fiyatları üretir.
+func Generate(seed string, ante, visit, reroll int, opts Options) []models.ShopItem {
+	r := rng.ForShop(seed, ante, visit, reroll)
+
+	slots := opts.Slots
+	if slots <= 0 {
+		slots = DefaultSlots
+	}
+
+	items := make([]models.ShopItem, 0, slots)
+	for slot := 0; slot < slots; slot++ {
+		item := rollItem(r, pick(r, itemWeights), opts.LockedJokers)
+		item.Slot = slot
+		if item.Type == ItemJoker && opts.EternalJokers {
+			item.Eternal = r.Chance(EternalChance)
+		}
+		items = append(items, item)
+	}
+
+	// Voucher reroll'dan etkilenmeyen ayrı bir slotta sunulur
+	if opts.Voucher != nil {
+		items = append(items, models.ShopItem{
+			Slot:   slots,
+			Type:   ItemVoucher,
+			ItemID: opts.Voucher.ID,
+			Name:   opts.Voucher.Name,
+			Price:  opts.Voucher.Price,
+			Rarity: jokers.Rare,
+		})
+	}
+	return items
+}
+
+// VoucherForAnte ante boyunca sunulacak voucher'ı sahip olunmayan ve kilitli
+// olmayanlar arasından seçer. Uygun voucher kalmadıysa nil döner.
+func VoucherForAnte(seed string, ante int, owned, locked []string) *vouchers.Definition {
+	var available []*vouchers.Definition
+	for _, def := range vouchers.Available(owned) {
+		if !contains(locked, def.ID) {
+			available = append(available, def)
+		}
+	}
+	if len(available) == 0 {
+		return nil
+	}
+	return available[rng.ForVoucher(seed, ante).Intn(len(available))]
+}
+
+// RerollCost yapılmış reroll sayısına ve voucher indirimine göre sıradaki reroll ücretini döndürür
+func RerollCost(rerolls, discount int) int {
+	cost := BaseRerollCost + rerolls*RerollIncrease - discount
+	if cost < 0 {
+		return 0
+	}
+	return cost
+}
+
+// rollItem verilen tipte rastgele bir dükkan öğesi üretir; kilitli jokerler sunulmaz
+func rollItem(r *rng.RNG, itemType string, lockedJokers []string) models.ShopItem {
+	switch itemType {
+	case ItemTarot:
+		all := tarots.All()
+		def := all[r.Intn(len(all))]
+		return models.ShopItem{Type: ItemTarot, ItemID: def.ID, Name: def.Name, Price: def.Price, Rarity: jokers.Uncommon}
+	case ItemPlanet:
+		all := planets.All()
+		def := all[r.Intn(len(all))]
+		return models.ShopItem{Type: ItemPlanet, ItemID: def.ID, Name: def.Name, Price: def.Price, Rarity: jokers.Rare}
+	case ItemPack:
+		all := packs.All()
+		def := all[r.Intn(len(all))]
+		return models.ShopItem{Type: ItemPack, ItemID: def.ID, Name: def.Name, Price: def.Price, Rarity: jokers.Common}
+	default:
+		// Nadirlik seç, o nadirlikte joker yoksa common'a düş
+		candidates := unlockedJokers(jokers.ByRarity(pick(r, rarityWeights)), lockedJokers)
+		if len(candidates) == 0 {
+			candidates = unlockedJokers(jokers.ByRarity(jokers.Common), lockedJokers)
+		}
+		def := candidates[r.Intn(len(candidates))]
+		return models.ShopItem{Type: ItemJoker, ItemID: def.ID, Name: def.Name, Price: def.Price(), Rarity: def.Rarity}
+	}
+}
+
+// unlockedJokers kilitli jokerleri listeden çıkarır
+func unlockedJokers(candidates []*jokers.Definition, locked []string) []*jokers.Definition {
+	var result []*jokers.Definition
+	for _, def := range candidates {
+		if !contains(locked, def.ID) {
+			result = append(result, def)
+		}
+	}
+	return result
+}
+
+// contains listenin öğeyi içerip içermediğini kontrol eder
+func contains(list []string, id string) bool {
+	for _, item := range list {
+		if item == id {
+			return true
+		}
+	}
+	return false
+}
+
+// pick ağırlıklı tablodan bir değer seçer
+func pick(r *rng.RNG, table []weighted) string {
+	weights := make([]int, len(table))
+	for i, w := range table {
+		weights[i] = w.weight
+	}
+	return table[r.WeightedIndex(weights)].value
+}
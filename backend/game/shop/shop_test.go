@@ -0,0 +1,51 @@
+package shop
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	first := Generate("SHOPSEED", 2, 3, 1, Options{})
+	second := Generate("SHOPSEED", 2, 3, 1, Options{})
+	if !reflect.DeepEqual(first, second) {
+		t.Fatal("same inputs produced different shops")
+	}
+}
+
+func TestGenerateSkipsLockedJokers(t *testing.T) {
+	locked := []string{"baron", "perfectionist", "cavendish"}
+	offered := map[string]bool{}
+	for i := 0; i < 500; i++ {
+		items := Generate(fmt.Sprintf("SEED%d", i), 1+i%8, 1, 0, Options{Slots: 10, LockedJokers: locked})
+		for _, item := range items {
+			if item.Type == ItemJoker {
+				offered[item.ItemID] = true
+			}
+		}
+	}
+	for _, id := range locked {
+		if offered[id] {
+			t.Errorf("locked joker %s was offered", id)
+		}
+	}
+	if len(offered) == 0 {
+		t.Fatal("no jokers were offered")
+	}
+}
+
+func TestVoucherForAnteSkipsLocked(t *testing.T) {
+	locked := []string{"voucher_seed_money", "voucher_overstock"}
+	for i := 0; i < 500; i++ {
+		def := VoucherForAnte(fmt.Sprintf("SEED%d", i), 1+i%8, nil, locked)
+		if def == nil {
+			t.Fatal("no voucher offered")
+		}
+		for _, id := range locked {
+			if def.ID == id {
+				t.Fatalf("locked voucher %s was offered", id)
+			}
+		}
+	}
+}
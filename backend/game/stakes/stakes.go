@@ -0,0 +1,125 @@
+package stakes
+
+import "errors"
+
+// ErrUnknownStake stake ID'si tanımlı değilse döner
+var ErrUnknownStake = errors.New("bilinmeyen stake")
+
+// Stake ID'leri (zorluk sırasıyla)
+const (
+	White  = "white"
+	Red    = "red"
+	Green  = "green"
+	Black  = "black"
+	Blue   = "blue"
+	Purple = "purple"
+	Orange = "orange"
+	Gold   = "gold"
+)
+
+// Default stake seçilmezse kullanılan stake
+const Default = White
+
+// Modifiers stake'in run kurallarına etkisi. Her stake kendinden önceki
+// tüm stake'lerin etkilerini de içerir.
+type Modifiers struct {
+	TargetMultiplier   float64 `json:"targetMultiplier"`             // Blind hedef çarpanı
+	NoSmallBlindReward bool    `json:"noSmallBlindReward,omitempty"` // Small blind ödül vermez
+	EternalJokers      bool    `json:"eternalJokers,omitempty"`      // Dükkanda eternal jokerler çıkabilir
+	InterestCapPenalty int     `json:"interestCapPenalty,omitempty"` // Faiz üst sınırından düşülen ($)
+	DiscardPenalty     int     `json:"discardPenalty,omitempty"`     // Her blind'da eksilen discard hakkı
+}
+
+// Add iki stake etkisini birleştirir
+func (m Modifiers) Add(other Modifiers) Modifiers {
+	multiplier := m.TargetMultiplier
+	if multiplier == 0 {
+		multiplier = 1
+	}
+	if other.TargetMultiplier != 0 {
+		multiplier *= other.TargetMultiplier
+	}
+	return Modifiers{
+		TargetMultiplier:   multiplier,
+		NoSmallBlindReward: m.NoSmallBlindReward || other.NoSmallBlindReward,
+		EternalJokers:      m.EternalJokers || other.EternalJokers,
+		InterestCapPenalty: m.InterestCapPenalty + other.InterestCapPenalty,
+		DiscardPenalty:     m.DiscardPenalty + other.DiscardPenalty,
+	}
+}
+
+// Definition stake tanımı
+type Definition struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Level       int       `json:"level"` // 0 = White
+	Description string    `json:"description"`
+	Modifier    Modifiers `json:"modifier"` // Yalnızca bu stake'in eklediği etki
+}
+
+// catalog tüm stake'ler zorluk sırasıyla
+var catalog = []Definition{
+	{ID: White, Name: "White Stake", Description: "Temel zorluk"},
+	{ID: Red, Name: "Red Stake", Description: "Small blind ödül vermez", Modifier: Modifiers{NoSmallBlindReward: true}},
+	{ID: Green, Name: "Green Stake", Description: "Blind hedefleri %25 artar", Modifier: Modifiers{TargetMultiplier: 1.25}},
+	{ID: Black, Name: "Black Stake", Description: "Dükkanda satılamayan eternal jokerler çıkabilir", Modifier: Modifiers{EternalJokers: true}},
+	{ID: Blue, Name: "Blue Stake", Description: "Her blind'da -1 discard", Modifier: Modifiers{DiscardPenalty: 1}},
+	{ID: Purple, Name: "Purple Stake", Description: "Blind hedefleri %20 daha artar", Modifier: Modifiers{TargetMultiplier: 1.2}},
+	{ID: Orange, Name: "Orange Stake", Description: "Faiz üst sınırı $2 azalır", Modifier: Modifiers{InterestCapPenalty: 2}},
+	{ID: Gold, Name: "Gold Stake", Description: "Blind hedefleri %20 daha artar", Modifier: Modifiers{TargetMultiplier: 1.2}},
+}
+
+func init() {
+	for i := range catalog {
+		catalog[i].Level = i
+	}
+}
+
+// Get ID ile stake tanımını döndürür; boş ID varsayılan stake'i döndürür
+func Get(id string) (*Definition, bool) {
+	if id == "" {
+		id = Default
+	}
+	for i := range catalog {
+		if catalog[i].ID == id {
+			return &catalog[i], true
+		}
+	}
+	return nil, false
+}
+
+// IsKnown stake ID'sinin tanımlı olup olmadığını kontrol eder
+func IsKnown(id string) bool {
+	_, ok := Get(id)
+	return ok && id != ""
+}
+
+// All tüm stake tanımlarını zorluk sırasıyla döndürür
+func All() []Definition {
+	result := make([]Definition, len(catalog))
+	copy(result, catalog)
+	return result
+}
+
+// Previous bir önceki stake'i döndürür (White için false)
+func Previous(id string) (*Definition, bool) {
+	def, ok := Get(id)
+	if !ok || def.Level == 0 {
+		return nil, false
+	}
+	return &catalog[def.Level-1], true
+}
+
+// Combined stake'in kendisi ve önceki tüm stake'lerin birleşik etkisini döndürür.
+// Bilinmeyen stake için temel (White) etkiler döner.
+func Combined(id string) Modifiers {
+	total := Modifiers{TargetMultiplier: 1}
+	def, ok := Get(id)
+	if !ok {
+		return total
+	}
+	for _, stake := range catalog[:def.Level+1] {
+		total = total.Add(stake.Modifier)
+	}
+	return total
+}
@@ -0,0 +1,248 @@
+package tarots
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"balatro-backend/game/cards"
+	"balatro-backend/game/jokers"
+	"balatro-backend/game/rng"
+	"balatro-backend/models"
+)
+
+// Tarot kullanım hataları
+var (
+	ErrUnknownTarot   = errors.New("bilinmeyen tarot kartı")
+	ErrNotInInventory = errors.New("tarot kartı envanterde yok")
+	ErrInvalidTargets = errors.New("geçersiz hedef kart seçimi")
+	ErrNoEffect       = errors.New("tarot kartının bu durumda etkisi yok")
+	ErrJokersRequired = errors.New("bu tarot kartı için en az bir joker gerekli")
+)
+
+// hermitMoneyCap The Hermit'in verebileceği en fazla para
+const hermitMoneyCap = 20
+
+// Definition tarot kartı tanımı
+type Definition struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	MinTargets  int    `json:"minTargets"` // Seçilmesi gereken en az el kartı
+	MaxTargets  int    `json:"maxTargets"` // Seçilebilecek en fazla el kartı
+	Price       int    `json:"price"`
+
+	apply func(state *models.PlayerState, targets []int, r *rng.RNG) error
+}
+
+// catalog tüm tarot kartları (frontend TarotCard.js TAROT_TYPES ile aynı kartlar)
+var catalog = map[string]*Definition{
+	"tarot_fool": {
+		ID:          "tarot_fool",
+		Name:        "The Fool",
+		Description: "Seçilen kartın türünü rastgele değiştirir",
+		MinTargets:  1,
+		MaxTargets:  1,
+		Price:       8,
+		apply: func(state *models.PlayerState, targets []int, r *rng.RNG) error {
+			card := &state.HandCards[targets[0]]
+
+			// Mevcut türden farklı bir tür seç
+			var options []string
+			for _, suit := range cards.Suits {
+				if suit != card.Suit {
+					options = append(options, suit)
+				}
+			}
+			card.Suit = options[r.Intn(len(options))]
+			return nil
+		},
+	},
+	"tarot_magician": {
+		ID:          "tarot_magician",
+		Name:        "The Magician",
+		Description: "Seçilen en fazla 2 kartın değerini bir üst değere yükseltir",
+		MinTargets:  1,
+		MaxTargets:  2,
+		Price:       8,
+		apply: func(state *models.PlayerState, targets []int, r *rng.RNG) error {
+			for _, i := range targets {
+				next, ok := cards.NextValue(state.HandCards[i].Value)
+				if !ok {
+					return fmt.Errorf("%w: kart değeri bilinmiyor", ErrInvalidTargets)
+				}
+				state.HandCards[i].Value = next
+			}
+			return nil
+		},
+	},
+	"tarot_hermit": {
+		ID:          "tarot_hermit",
+		Name:        "The Hermit",
+		Description: fmt.Sprintf("Paranı ikiye katlar (en fazla $%d)", hermitMoneyCap),
+		Price:       8,
+		apply: func(state *models.PlayerState, targets []int, r *rng.RNG) error {
+			bonus := state.Money
+			if bonus > hermitMoneyCap {
+				bonus = hermitMoneyCap
+			}
+			if bonus <= 0 {
+				return ErrNoEffect
+			}
+			state.Money += bonus
+			return nil
+		},
+	},
+	"tarot_strength": {
+		ID:          "tarot_strength",
+		Name:        "The Strength",
+		Description: "Seçilen en fazla 2 kartı Steel enhancement ile güçlendirir",
+		MinTargets:  1,
+		MaxTargets:  2,
+		Price:       8,
+		apply: func(state *models.PlayerState, targets []int, r *rng.RNG) error {
+			return addEnhancement(state, targets, cards.Steel)
+		},
+	},
+	"tarot_wheel": {
+		ID:          "tarot_wheel",
+		Name:        "The Wheel of Fortune",
+		Description: "Rastgele bir joker efektini tetikler",
+		Price:       8,
+		apply: func(state *models.PlayerState, targets []int, r *rng.RNG) error {
+			if len(state.Jokers) == 0 {
+				return ErrJokersRequired
+			}
+
+			// Rastgele bir joker seç ve istatistiğini güncelle
+			joker := &state.Jokers[r.Intn(len(state.Jokers))]
+			if joker.Stats == nil {
+				joker.Stats = map[string]interface{}{}
+			}
+			jokers.AddStat(joker.Stats, "wheelActivations", 1)
+			return nil
+		},
+	},
+	"tarot_emperor": {
+		ID:          "tarot_emperor",
+		Name:        "The Emperor",
+		Description: "Ele +1 çip bonuslu rastgele yeni bir kart ekler",
+		Price:       8,
+		apply: func(state *models.PlayerState, targets []int, r *rng.RNG) error {
+			state.HandCards = append(state.HandCards, models.Card{
+				Suit:         cards.Suits[r.Intn(len(cards.Suits))],
+				Value:        cards.Values[r.Intn(len(cards.Values))],
+				Enhancements: []models.Enhancement{cards.BonusChip1},
+			})
+			return nil
+		},
+	},
+}
+
+// Get ID ile tarot tanımını döndürür
+func Get(id string) (*Definition, bool) {
+	def, ok := catalog[id]
+	return def, ok
+}
+
+// IsKnown tarot ID'sinin tanımlı olup olmadığını kontrol eder
+func IsKnown(id string) bool {
+	_, ok := catalog[id]
+	return ok
+}
+
+// All tüm tarot tanımlarını ID sırasıyla döndürür
+func All() []*Definition {
+	result := make([]*Definition, 0, len(catalog))
+	for _, def := range catalog {
+		result = append(result, def)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+// Use envanterdeki bir tarot kartını seçilen el kartlarına uygular ve envanterden düşer.
+// Hata dönerse durum değiştirilmemiş kabul edilmelidir.
+func Use(state *models.PlayerState, id string, targets []int, r *rng.RNG) error {
+	def, ok := catalog[id]
+	if !ok {
+		return ErrUnknownTarot
+	}
+
+	slot := -1
+	for i, tarot := range state.TarotCardsInventory {
+		if tarot.ID == id && tarot.Quantity > 0 {
+			slot = i
+			break
+		}
+	}
+	if slot < 0 {
+		return ErrNotInInventory
+	}
+
+	if err := validateTargets(def, targets, len(state.HandCards)); err != nil {
+		return err
+	}
+
+	if err := def.apply(state, targets, r); err != nil {
+		return err
+	}
+
+	// Envanterden düş
+	state.TarotCardsInventory[slot].Quantity--
+	if state.TarotCardsInventory[slot].Quantity == 0 {
+		state.TarotCardsInventory = append(state.TarotCardsInventory[:slot], state.TarotCardsInventory[slot+1:]...)
+	}
+	return nil
+}
+
+// AddToInventory tarot kartını envantere ekler
+func AddToInventory(state *models.PlayerState, id string) {
+	for i := range state.TarotCardsInventory {
+		if state.TarotCardsInventory[i].ID == id {
+			state.TarotCardsInventory[i].Quantity++
+			return
+		}
+	}
+	state.TarotCardsInventory = append(state.TarotCardsInventory, models.TarotCard{ID: id, Quantity: 1})
+}
+
+// validateTargets hedef indekslerinin sayısını, geçerliliğini ve tekrarsızlığını kontrol eder
+func validateTargets(def *Definition, targets []int, handSize int) error {
+	if len(targets) < def.MinTargets || len(targets) > def.MaxTargets {
+		if def.MaxTargets == 0 {
+			return fmt.Errorf("%w: %s hedef kart almaz", ErrInvalidTargets, def.Name)
+		}
+		return fmt.Errorf("%w: %s için %d-%d kart seçilmeli", ErrInvalidTargets, def.Name, def.MinTargets, def.MaxTargets)
+	}
+
+	seen := map[int]bool{}
+	for _, i := range targets {
+		if i < 0 || i >= handSize {
+			return fmt.Errorf("%w: indeks %d elde yok", ErrInvalidTargets, i)
+		}
+		if seen[i] {
+			return fmt.Errorf("%w: indeks %d birden fazla seçildi", ErrInvalidTargets, i)
+		}
+		seen[i] = true
+	}
+	return nil
+}
+
+// addEnhancement hedef kartlara enhancement ekler (aynı gruptaki enhancement'ın yerini alır);
+// hepsinde zaten varsa ErrNoEffect döner
+func addEnhancement(state *models.PlayerState, targets []int, enhancement models.Enhancement) error {
+	changed := false
+	for _, i := range targets {
+		card, ok := cards.WithEnhancement(state.HandCards[i], enhancement)
+		if !ok {
+			continue
+		}
+		state.HandCards[i] = card
+		changed = true
+	}
+	if !changed {
+		return ErrNoEffect
+	}
+	return nil
+}
@@ -0,0 +1,330 @@
+package unlocks
+
+import (
+	"time"
+
+	"balatro-backend/game/decks"
+	"balatro-backend/game/hands"
+	"balatro-backend/game/jokers"
+	"balatro-backend/game/stakes"
+	"balatro-backend/game/vouchers"
+	"balatro-backend/models"
+)
+
+// Koleksiyon kategorileri
+const (
+	CategoryJokers   = "jokers"
+	CategoryVouchers = "vouchers"
+	CategoryDecks    = "decks"
+	CategoryStakes   = "stakes"
+)
+
+// Categories koleksiyonda gösterilen kategoriler (gösterim sırası)
+var Categories = []string{CategoryJokers, CategoryVouchers, CategoryDecks, CategoryStakes}
+
+// Öğe durumları
+const (
+	StatusLocked     = "locked"     // Kilidi açılmamış
+	StatusUnlocked   = "unlocked"   // Kilidi açık, henüz kullanılmamış
+	StatusDiscovered = "discovered" // Bir run'da kullanılmış
+)
+
+// Item koleksiyondaki bir öğe
+type Item struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Entry koleksiyon görünümündeki bir öğe ve durumu
+type Entry struct {
+	Item
+	Status string `json:"status"`
+	Hint   string `json:"hint,omitempty"` // Kilitliyse açma koşulu
+}
+
+// RunSummary kural değerlendirmesi için biten run'ın özeti
+type RunSummary struct {
+	Won        bool
+	FinalAnte  int
+	Score      int64
+	HandCounts map[string]int
+	Jokers     []string
+	Vouchers   []string
+	Deck       string
+	Stake      string
+}
+
+// Rule run sonunda değerlendirilen kilit açma kuralı
+type Rule struct {
+	ID          string `json:"id"`
+	Category    string `json:"category"`
+	ItemID      string `json:"itemId"`
+	Description string `json:"description"`
+
+	check func(summary RunSummary) bool
+}
+
+// rules tüm kilit açma kuralları; hedef öğeler başlangıçta kilitlidir
+var rules = []Rule{
+	{
+		ID:          "reach_ante_4",
+		Category:    CategoryJokers,
+		ItemID:      "baron",
+		Description: "Bir run'da ante 4'e ulaş",
+		check:       func(s RunSummary) bool { return s.FinalAnte >= 4 },
+	},
+	{
+		ID:          "flush_only_win",
+		Category:    CategoryJokers,
+		ItemID:      "perfectionist",
+		Description: "Yalnızca Flush oynayarak bir run kazan",
+		check:       func(s RunSummary) bool { return s.Won && onlyHand(s.HandCounts, hands.Flush) },
+	},
+	{
+		ID:          "win_run",
+		Category:    CategoryJokers,
+		ItemID:      "cavendish",
+		Description: "Bir run kazan",
+		check:       func(s RunSummary) bool { return s.Won },
+	},
+	{
+		ID:          "reach_ante_3",
+		Category:    CategoryDecks,
+		ItemID:      decks.Abandoned,
+		Description: "Bir run'da ante 3'e ulaş",
+		check:       func(s RunSummary) bool { return s.FinalAnte >= 3 },
+	},
+	{
+		ID:          "reach_ante_6",
+		Category:    CategoryDecks,
+		ItemID:      decks.Checkered,
+		Description: "Bir run'da ante 6'ya ulaş",
+		check:       func(s RunSummary) bool { return s.FinalAnte >= 6 },
+	},
+	{
+		ID:          "score_10000",
+		Category:    CategoryVouchers,
+		ItemID:      "voucher_seed_money",
+		Description: "Bir run'da toplam 10000 skora ulaş",
+		check:       func(s RunSummary) bool { return s.Score >= 10000 },
+	},
+	{
+		ID:          "own_two_vouchers",
+		Category:    CategoryVouchers,
+		ItemID:      "voucher_overstock",
+		Description: "Bir run'da 2 voucher satın al",
+		check:       func(s RunSummary) bool { return len(s.Vouchers) >= 2 },
+	},
+}
+
+func init() {
+	// Her stake bir öncekinde run kazanılarak açılır
+	for _, stake := range stakes.All() {
+		previous, ok := stakes.Previous(stake.ID)
+		if !ok {
+			continue
+		}
+		previousID := previous.ID
+		rules = append(rules, Rule{
+			ID:          "win_" + previousID + "_stake",
+			Category:    CategoryStakes,
+			ItemID:      stake.ID,
+			Description: previous.Name + " ile bir run kazan",
+			check:       func(s RunSummary) bool { return s.Won && s.Stake == previousID },
+		})
+	}
+}
+
+// Rules tüm kilit açma kurallarını döndürür
+func Rules() []Rule {
+	return rules
+}
+
+// Items kategorideki tüm öğeleri döndürür
+func Items(category string) []Item {
+	var items []Item
+	switch category {
+	case CategoryJokers:
+		for _, def := range jokers.All() {
+			items = append(items, Item{ID: def.ID, Name: def.Name})
+		}
+	case CategoryVouchers:
+		for _, def := range vouchers.All() {
+			items = append(items, Item{ID: def.ID, Name: def.Name})
+		}
+	case CategoryDecks:
+		for _, def := range decks.All() {
+			items = append(items, Item{ID: def.ID, Name: def.Name})
+		}
+	case CategoryStakes:
+		for _, def := range stakes.All() {
+			items = append(items, Item{ID: def.ID, Name: def.Name})
+		}
+	}
+	return items
+}
+
+// Summarize biten run'ın özetini çıkarır
+func Summarize(current *models.Run, won bool) RunSummary {
+	summary := RunSummary{
+		Won:        won,
+		FinalAnte:  current.State.CurrentAnte,
+		Score:      current.State.CurrentScore,
+		HandCounts: current.HandCounts,
+		Vouchers:   current.State.VouchersOwned,
+		Deck:       current.DeckID,
+		Stake:      current.StakeID,
+	}
+	for _, joker := range current.State.Jokers {
+		summary.Jokers = append(summary.Jokers, joker.ID)
+	}
+	return summary
+}
+
+// NewProfile varsayılan kilitleri açık yeni bir profil oluşturur
+func NewProfile(userID string) *models.Profile {
+	now := time.Now()
+	profile := &models.Profile{
+		UserID:     userID,
+		Unlocked:   map[string][]string{},
+		Discovered: map[string][]string{},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	for _, category := range Categories {
+		profile.Unlocked[category] = []string{}
+		profile.Discovered[category] = []string{}
+		for _, item := range Items(category) {
+			if !lockedByRule(category, item.ID) {
+				profile.Unlocked[category] = append(profile.Unlocked[category], item.ID)
+			}
+		}
+	}
+	return profile
+}
+
+// Evaluate biten run'ı profile işler: istatistikleri günceller, kullanılan öğeleri
+// keşfedilmiş olarak işaretler ve koşulu sağlanan kuralların kilidini açar.
+// Yeni açılan kuralları döndürür.
+func Evaluate(profile *models.Profile, summary RunSummary) []Rule {
+	profile.Stats.RunsPlayed++
+	if summary.Won {
+		profile.Stats.RunsWon++
+	}
+	if summary.Score > profile.Stats.BestScore {
+		profile.Stats.BestScore = summary.Score
+	}
+	if summary.FinalAnte > profile.Stats.HighestAnte {
+		profile.Stats.HighestAnte = summary.FinalAnte
+	}
+
+	for _, id := range summary.Jokers {
+		add(profile.Discovered, CategoryJokers, id)
+	}
+	for _, id := range summary.Vouchers {
+		add(profile.Discovered, CategoryVouchers, id)
+	}
+	if summary.Deck != "" {
+		add(profile.Discovered, CategoryDecks, summary.Deck)
+	}
+	if summary.Stake != "" {
+		add(profile.Discovered, CategoryStakes, summary.Stake)
+	}
+
+	var unlocked []Rule
+	for _, rule := range rules {
+		if contains(profile.Unlocked[rule.Category], rule.ItemID) || !rule.check(summary) {
+			continue
+		}
+		add(profile.Unlocked, rule.Category, rule.ItemID)
+		unlocked = append(unlocked, rule)
+	}
+
+	profile.UpdatedAt = time.Now()
+	return unlocked
+}
+
+// Collection profilin kategori bazında keşfedilmiş/kilitli görünümünü döndürür
+func Collection(profile *models.Profile) map[string][]Entry {
+	result := map[string][]Entry{}
+	for _, category := range Categories {
+		entries := []Entry{}
+		for _, item := range Items(category) {
+			entry := Entry{Item: item, Status: StatusLocked}
+			switch {
+			case contains(profile.Discovered[category], item.ID):
+				entry.Status = StatusDiscovered
+			case IsUnlocked(profile, category, item.ID):
+				entry.Status = StatusUnlocked
+			default:
+				entry.Hint = hint(category, item.ID)
+			}
+			entries = append(entries, entry)
+		}
+		result[category] = entries
+	}
+	return result
+}
+
+// IsUnlocked öğenin profil için kullanılabilir olup olmadığını kontrol eder
+func IsUnlocked(profile *models.Profile, category, id string) bool {
+	return contains(profile.Unlocked[category], id) || !lockedByRule(category, id)
+}
+
+// Locked profilde henüz açılmamış öğeleri kategori bazında döndürür. Run
+// başlarken alınan bu liste run boyunca dükkanın hangi öğeleri sunmayacağını
+// belirler; run içinde açılan öğeler bir sonraki run'da kullanılabilir olur.
+func Locked(profile *models.Profile) map[string][]string {
+	locked := map[string][]string{}
+	for _, rule := range rules {
+		if !IsUnlocked(profile, rule.Category, rule.ItemID) {
+			add(locked, rule.Category, rule.ItemID)
+		}
+	}
+	return locked
+}
+
+// lockedByRule öğenin bir kuralla açılması gerekip gerekmediğini kontrol eder
+func lockedByRule(category, id string) bool {
+	return hint(category, id) != ""
+}
+
+// hint öğeyi açan kuralın açıklamasını döndürür
+func hint(category, id string) string {
+	for _, rule := range rules {
+		if rule.Category == category && rule.ItemID == id {
+			return rule.Description
+		}
+	}
+	return ""
+}
+
+// onlyHand run'da oynanan tüm ellerin verilen türde olup olmadığını kontrol eder
+func onlyHand(counts map[string]int, handType hands.HandType) bool {
+	info, _ := hands.Info(handType)
+	played := 0
+	for name, count := range counts {
+		if name != info.Name && count > 0 {
+			return false
+		}
+		played += count
+	}
+	return played > 0
+}
+
+// add öğeyi kategoriye tekrarsız ekler
+func add(lists map[string][]string, category, id string) {
+	if !contains(lists[category], id) {
+		lists[category] = append(lists[category], id)
+	}
+}
+
+// contains listenin öğeyi içerip içermediğini kontrol eder
+func contains(list []string, id string) bool {
+	for _, item := range list {
+		if item == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+package vouchers
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrUnknownVoucher voucher ID'si tanımlı değilse döner
+var ErrUnknownVoucher = errors.New("bilinmeyen voucher")
+
+// Ekonomi kuralları
+const (
+	Price           = 10 // Voucher fiyatı
+	BaseInterestCap = 5  // Vouchersız en fazla faiz ($)
+)
+
+// Effect voucher'ın kalıcı run etkileri
+type Effect struct {
+	ExtraHands       int `json:"extraHands,omitempty"`       // Her blind'da ekstra el hakkı
+	ExtraDiscards    int `json:"extraDiscards,omitempty"`    // Her blind'da ekstra discard hakkı
+	ExtraShopSlots   int `json:"extraShopSlots,omitempty"`   // Dükkanda ekstra öğe
+	RerollDiscount   int `json:"rerollDiscount,omitempty"`   // Reroll ücreti indirimi ($)
+	InterestCapBonus int `json:"interestCapBonus,omitempty"` // Faiz üst sınırı artışı ($)
+}
+
+// Add iki efekti toplar
+func (e Effect) Add(other Effect) Effect {
+	return Effect{
+		ExtraHands:       e.ExtraHands + other.ExtraHands,
+		ExtraDiscards:    e.ExtraDiscards + other.ExtraDiscards,
+		ExtraShopSlots:   e.ExtraShopSlots + other.ExtraShopSlots,
+		RerollDiscount:   e.RerollDiscount + other.RerollDiscount,
+		InterestCapBonus: e.InterestCapBonus + other.InterestCapBonus,
+	}
+}
+
+// Definition voucher tanımı
+type Definition struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Price       int    `json:"price"`
+	Effect      Effect `json:"effect"`
+}
+
+// catalog tüm voucher'lar
+var catalog = map[string]*Definition{
+	"voucher_grabber": {
+		ID:          "voucher_grabber",
+		Name:        "Grabber",
+		Description: "Her blind'da +1 el hakkı",
+		Price:       Price,
+		Effect:      Effect{ExtraHands: 1},
+	},
+	"voucher_wasteful": {
+		ID:          "voucher_wasteful",
+		Name:        "Wasteful",
+		Description: "Her blind'da +1 discard hakkı",
+		Price:       Price,
+		Effect:      Effect{ExtraDiscards: 1},
+	},
+	"voucher_overstock": {
+		ID:          "voucher_overstock",
+		Name:        "Overstock",
+		Description: "Dükkanda +1 öğe",
+		Price:       Price,
+		Effect:      Effect{ExtraShopSlots: 1},
+	},
+	"voucher_reroll_surplus": {
+		ID:          "voucher_reroll_surplus",
+		Name:        "Reroll Surplus",
+		Description: "Reroll ücreti $2 azalır",
+		Price:       Price,
+		Effect:      Effect{RerollDiscount: 2},
+	},
+	"voucher_seed_money": {
+		ID:          "voucher_seed_money",
+		Name:        "Seed Money",
+		Description: "Faiz üst sınırı $5 artar",
+		Price:       Price,
+		Effect:      Effect{InterestCapBonus: 5},
+	},
+}
+
+// Get ID ile voucher tanımını döndürür
+func Get(id string) (*Definition, bool) {
+	def, ok := catalog[id]
+	return def, ok
+}
+
+// IsKnown voucher ID'sinin tanımlı olup olmadığını kontrol eder
+func IsKnown(id string) bool {
+	_, ok := catalog[id]
+	return ok
+}
+
+// All tüm voucher tanımlarını ID sırasıyla döndürür
+func All() []*Definition {
+	result := make([]*Definition, 0, len(catalog))
+	for _, def := range catalog {
+		result = append(result, def)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+// Owns voucher'ın sahip olunanlar arasında olup olmadığını kontrol eder
+func Owns(owned []string, id string) bool {
+	for _, o := range owned {
+		if o == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Available henüz sahip olunmayan voucher'ları ID sırasıyla döndürür
+func Available(owned []string) []*Definition {
+	var result []*Definition
+	for _, def := range All() {
+		if !Owns(owned, def.ID) {
+			result = append(result, def)
+		}
+	}
+	return result
+}
+
+// Combined sahip olunan tüm voucher'ların toplam etkisini döndürür
+func Combined(owned []string) Effect {
+	var total Effect
+	for _, id := range owned {
+		if def, ok := catalog[id]; ok {
+			total = total.Add(def.Effect)
+		}
+	}
+	return total
+}
+
+// InterestCap sahip olunan voucher'lara göre faiz üst sınırını döndürür
+func InterestCap(owned []string) int {
+	return BaseInterestCap + Combined(owned).InterestCapBonus
+}
@@ -0,0 +1,319 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"balatro-backend/middleware"
+	"balatro-backend/models"
+	"balatro-backend/moderation"
+	"balatro-backend/store"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AdminHandler liderlik tablosu moderasyonu endpoint'leri
+type AdminHandler struct {
+	Highscores store.HighscoreStore
+	Moderation store.ModerationStore
+}
+
+// NewAdminHandler verilen depolarla yönetim handler'ı oluşturur
+func NewAdminHandler(highscores store.HighscoreStore, moderation store.ModerationStore) *AdminHandler {
+	return &AdminHandler{Highscores: highscores, Moderation: moderation}
+}
+
+// pagination limit ve offset query parametrelerini okur (varsayılan 50, en fazla 500)
+func pagination(c *gin.Context) (int, int) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit < 1 || limit > 500 {
+		limit = 50
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// ListSuspiciousHighscores şüpheli skorları listeler - GET /api/admin/highscores/suspicious
+// Skorlar depoda liderlik sırasıyla sayfalanır ve sayfadaki şüpheli olanlar döner;
+// bir sayfa limit'ten az sonuç içerebilir. Tekrarlar tüm skorlar arasında aranır.
+// Varsayılan olarak zaten işaretlenmiş, silinmiş veya yasaklı kullanıcılara ait
+// skorlar taranmaz (?includeModerated=true).
+func (h *AdminHandler) ListSuspiciousHighscores(c *gin.Context) {
+	limit, offset := pagination(c)
+	includeModerated := c.Query("includeModerated") == "true"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	filter := store.HighscoreFilter{VisibleOnly: !includeModerated}
+	highscores, total, err := h.Highscores.List(ctx, filter, limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Yüksek skorlar yüklenemedi",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	suspicious := []models.SuspiciousHighscore{}
+	for _, highscore := range highscores {
+		original, err := h.Highscores.FirstSubmission(ctx, highscore)
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			c.JSON(http.StatusInternalServerError, models.APIResponse{
+				Success: false,
+				Message: "Yüksek skorlar incelenemedi",
+				Error:   err.Error(),
+			})
+			return
+		}
+		if finding, ok := moderation.Check(highscore, original); ok {
+			suspicious = append(suspicious, finding)
+		}
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Şüpheli skorlar listelendi",
+		Data: map[string]interface{}{
+			"highscores": suspicious,
+			"scanned":    len(highscores), // Bu sayfada incelenen skor sayısı
+			"totalCount": total,           // Taranabilecek toplam skor sayısı
+			"limit":      limit,
+			"offset":     offset,
+			"hasMore":    int64(offset+len(highscores)) < total,
+		},
+	})
+}
+
+// ModerateHighscore skoru işaretler, işareti kaldırır, soft-delete eder veya geri
+// getirir - POST /api/admin/highscores/:id/moderate
+func (h *AdminHandler) ModerateHighscore(c *gin.Context) {
+	id, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Message: "Geçersiz skor ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	var request models.ModerateHighscoreRequest
+	if !bindJSON(c, &request) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	highscore, err := h.Highscores.Get(ctx, id)
+	if err != nil {
+		respondStoreError(c, err, "Yüksek skor bulunamadı", "Yüksek skor yüklenemedi")
+		return
+	}
+
+	// Diğer moderasyon alanları korunur
+	update := store.HighscoreModeration{
+		Flagged:    highscore.Flagged,
+		FlagReason: highscore.FlagReason,
+		DeletedAt:  highscore.DeletedAt,
+	}
+	switch request.Action {
+	case models.ModerationFlag:
+		update.Flagged = true
+		update.FlagReason = request.Reason
+	case models.ModerationUnflag:
+		update.Flagged = false
+		update.FlagReason = ""
+	case models.ModerationDelete:
+		now := time.Now()
+		update.DeletedAt = &now
+	case models.ModerationRestore:
+		update.DeletedAt = nil
+	}
+
+	if err := h.Highscores.Moderate(ctx, id, update); err != nil {
+		respondStoreError(c, err, "Yüksek skor bulunamadı", "Yüksek skor güncellenemedi")
+		return
+	}
+	detail := request.Action
+	if request.Reason != "" {
+		detail += ": " + request.Reason
+	}
+	h.audit(c, models.AuditHighscoreModerated, id.Hex(), detail)
+
+	highscore.Flagged = update.Flagged
+	highscore.FlagReason = update.FlagReason
+	highscore.DeletedAt = update.DeletedAt
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Yüksek skor güncellendi",
+		Data:    highscore,
+	})
+}
+
+// BanUser kullanıcıyı liderlik tablolarından yasaklar - POST /api/admin/bans/:userId
+func (h *AdminHandler) BanUser(c *gin.Context) {
+	var request models.BanUserRequest
+	if !bindJSON(c, &request) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ban := models.LeaderboardBan{
+		UserID:    c.Param("userId"),
+		Reason:    request.Reason,
+		BannedBy:  middleware.AdminID(c),
+		CreatedAt: time.Now(),
+	}
+	if err := h.Moderation.Ban(ctx, &ban); err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Kullanıcı yasaklanamadı",
+			Error:   err.Error(),
+		})
+		return
+	}
+	// Liderlik tabloları yasak listesini değil skorlardaki işareti sorgular;
+	// işaretleme başarısız olursa yasak tekrarlanarak tamamlanabilir
+	if err := h.Highscores.SetBanned(ctx, ban.UserID, true); err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Kullanıcının skorları gizlenemedi",
+			Error:   err.Error(),
+		})
+		return
+	}
+	h.audit(c, models.AuditUserBanned, ban.UserID, request.Reason)
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Kullanıcı liderlik tablolarından yasaklandı",
+		Data:    ban,
+	})
+}
+
+// UnbanUser kullanıcının yasağını kaldırır - DELETE /api/admin/bans/:userId
+func (h *AdminHandler) UnbanUser(c *gin.Context) {
+	userID := c.Param("userId")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// İşaret yasaktan önce kaldırılır; böylece başarısız bir istek tekrarlanabilir
+	if err := h.Highscores.SetBanned(ctx, userID, false); err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Kullanıcının skorları geri getirilemedi",
+			Error:   err.Error(),
+		})
+		return
+	}
+	if err := h.Moderation.Unban(ctx, userID); err != nil {
+		respondStoreError(c, err, "Kullanıcı yasaklı değil", "Yasak kaldırılamadı")
+		return
+	}
+	h.audit(c, models.AuditUserUnbanned, userID, "")
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Yasak kaldırıldı",
+	})
+}
+
+// ListBans yasaklı kullanıcıları listeler - GET /api/admin/bans
+func (h *AdminHandler) ListBans(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	bans, err := h.Moderation.Bans(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Yasaklar yüklenemedi",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Yasaklar listelendi",
+		Data:    bans,
+	})
+}
+
+// ListAuditLog admin işlemlerini en yeniden eskiye listeler - GET /api/admin/audit-log
+func (h *AdminHandler) ListAuditLog(c *gin.Context) {
+	limit, offset := pagination(c)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	entries, total, err := h.Moderation.AuditLog(ctx, limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Audit log yüklenemedi",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Audit log listelendi",
+		Data: map[string]interface{}{
+			"entries":    entries,
+			"totalCount": total,
+			"limit":      limit,
+			"offset":     offset,
+			"hasMore":    int64(offset+limit) < total,
+		},
+	})
+}
+
+// audit admin işlemini audit log'a yazar. İşlem zaten uygulandığı için
+// yazılamazsa yalnızca loglanır.
+func (h *AdminHandler) audit(c *gin.Context, action, target, detail string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	entry := models.AuditLogEntry{
+		Admin:     middleware.AdminID(c),
+		Action:    action,
+		Target:    target,
+		Detail:    detail,
+		CreatedAt: time.Now(),
+	}
+	if err := h.Moderation.AppendAudit(ctx, &entry); err != nil {
+		log.Printf("⚠️ Audit log yazılamadı (%s %s): %v", action, target, err)
+	}
+}
+
+// respondStoreError depo hatasını ErrNotFound için 404, diğerleri için 500 ile yanıtlar
+func respondStoreError(c *gin.Context, err error, notFound, failed string) {
+	if errors.Is(err, store.ErrNotFound) {
+		c.JSON(http.StatusNotFound, models.APIResponse{
+			Success: false,
+			Message: notFound,
+		})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, models.APIResponse{
+		Success: false,
+		Message: failed,
+		Error:   err.Error(),
+	})
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"balatro-backend/models"
+)
+
+func TestListSuspiciousHighscoresFindsEarlierDuplicates(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	original := models.Highscore{UserID: "ada", Score: 300, FinalBlind: 1, Seed: "REPLAYED", Deck: "red", Stake: "white", DateAchieved: now.AddDate(-2, 0, 0)}
+	for _, highscore := range []*models.Highscore{
+		&original,
+		{UserID: "grace", Score: 900, FinalBlind: 1, Seed: "HONEST", Deck: "red", Stake: "white", DateAchieved: now},
+		{UserID: "mallory", Score: 300, FinalBlind: 1, Seed: "REPLAYED", Deck: "red", Stake: "white", DateAchieved: now},
+	} {
+		if err := s.stores.Highscores.Insert(ctx, highscore); err != nil {
+			t.Fatalf("Insert: %v", err)
+		}
+	}
+
+	// Sıralama 900, 300 (tekrar), 300 (asıl); ikinci sayfa yalnızca tekrarı tarar
+	// ve asıl gönderim sayfa dışında olduğu halde bulunur
+	code, response := s.do(t, http.MethodGet, "/api/admin/highscores/suspicious?limit=1&offset=1", "", nil)
+	if code != http.StatusOK {
+		t.Fatalf("list: %d %+v", code, response)
+	}
+	data, _ := response.Data.(map[string]interface{})
+	list, _ := data["highscores"].([]interface{})
+	if len(list) != 1 || data["totalCount"] != float64(3) || data["hasMore"] != true {
+		t.Fatalf("list = %+v; want one finding on a page of 3 scores", data)
+	}
+	finding := list[0].(map[string]interface{})
+	reasons, _ := finding["reasons"].([]interface{})
+	if highscore := finding["highscore"].(map[string]interface{}); highscore["userId"] != "mallory" ||
+		len(reasons) != 1 || reasons[0] != models.SuspicionDuplicate {
+		t.Errorf("finding = %+v; want mallory's score as a duplicate of %s", finding, original.ID.Hex())
+	}
+
+	// Asıl gönderim tekrar sayılmaz
+	code, response = s.do(t, http.MethodGet, "/api/admin/highscores/suspicious?limit=1&offset=2", "", nil)
+	if data, _ := response.Data.(map[string]interface{}); code != http.StatusOK || len(data["highscores"].([]interface{})) != 0 {
+		t.Errorf("original page = %d %+v; want no findings", code, response.Data)
+	}
+}
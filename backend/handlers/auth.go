@@ -0,0 +1,321 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"balatro-backend/auth"
+	"balatro-backend/middleware"
+	"balatro-backend/models"
+	"balatro-backend/store"
+
+	"github.com/gin-gonic/gin"
+)
+
+// usernamePattern izin verilen kullanıcı adı karakterleri
+var usernamePattern = regexp.MustCompile(`^[a-z0-9_.-]+$`)
+
+// AuthHandler hesap oluşturma, giriş ve misafir endpoint'leri
+type AuthHandler struct {
+	Users    store.UserStore
+	Accounts store.AccountStore
+	Tokens   *auth.Signer
+}
+
+// NewAuthHandler verilen depolar ve token imzalayıcıyla auth handler'ı oluşturur
+func NewAuthHandler(users store.UserStore, accounts store.AccountStore, tokens *auth.Signer) *AuthHandler {
+	return &AuthHandler{Users: users, Accounts: accounts, Tokens: tokens}
+}
+
+// Register yeni hesap oluşturur ve oturum token'ı döndürür - POST /api/auth/register
+func (h *AuthHandler) Register(c *gin.Context) {
+	var request models.RegisterRequest
+
+	// JSON request'i parse et
+	if !bindJSON(c, &request) {
+		return
+	}
+
+	user, ok := newAccount(c, request)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := h.Users.Create(ctx, &user); err != nil {
+		if errors.Is(err, store.ErrDuplicate) {
+			c.JSON(http.StatusConflict, models.APIResponse{
+				Success: false,
+				Message: "Kullanıcı adı zaten alınmış",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Hesap oluşturulamadı",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.respondWithToken(c, http.StatusCreated, "Hesap başarıyla oluşturuldu", &user, "")
+}
+
+// Guest misafir oturumu açar - POST /api/auth/guest
+// Cihaz token'ı gönderilmezse yeni bir misafir ve cihaz token'ı oluşturulur;
+// gönderilirse o cihazın misafir hesabı için yeni oturum token'ı döner.
+func (h *AuthHandler) Guest(c *gin.Context) {
+	var request models.GuestRequest
+
+	// Body opsiyoneldir
+	if c.Request.ContentLength != 0 {
+		if !bindJSON(c, &request) {
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Mevcut cihaz: misafir hesabına yeniden giriş
+	if request.DeviceToken != "" {
+		user, err := h.Users.GetByDeviceToken(ctx, auth.HashDeviceToken(request.DeviceToken))
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			c.JSON(http.StatusInternalServerError, models.APIResponse{
+				Success: false,
+				Message: "Misafir oturumu açılamadı",
+				Error:   err.Error(),
+			})
+			return
+		}
+		if user == nil || !user.Guest {
+			c.JSON(http.StatusUnauthorized, models.APIResponse{
+				Success: false,
+				Message: "Geçersiz cihaz token'ı",
+			})
+			return
+		}
+
+		h.respondWithToken(c, http.StatusOK, "Misafir oturumu açıldı", user, "")
+		return
+	}
+
+	// Yeni cihaz: görünen adı üretilmiş yeni misafir
+	deviceToken, err := auth.NewDeviceToken()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Misafir hesabı oluşturulamadı",
+			Error:   err.Error(),
+		})
+		return
+	}
+	displayName, err := auth.GuestName()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Misafir hesabı oluşturulamadı",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	user := models.User{
+		DisplayName:     displayName,
+		Guest:           true,
+		DeviceTokenHash: auth.HashDeviceToken(deviceToken),
+		CreatedAt:       time.Now(),
+	}
+	if err := h.Users.Create(ctx, &user); err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Misafir hesabı oluşturulamadı",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	h.respondWithToken(c, http.StatusCreated, "Misafir hesabı oluşturuldu", &user, deviceToken)
+}
+
+// UpgradeGuest oturumdaki misafir hesabını kullanıcı adı ve şifreli tam hesaba
+// çevirir; kayıtlar, skorlar ve kilitler yeni hesaba taşınır - POST /api/auth/upgrade
+func (h *AuthHandler) UpgradeGuest(c *gin.Context) {
+	var request models.RegisterRequest
+
+	// JSON request'i parse et
+	if !bindJSON(c, &request) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	guestID := middleware.UserID(c)
+	guest, err := h.Users.GetByID(ctx, guestID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, store.ErrNotFound) {
+			status = http.StatusUnauthorized
+		}
+		c.JSON(status, models.APIResponse{
+			Success: false,
+			Message: "Hesap bulunamadı",
+			Error:   err.Error(),
+		})
+		return
+	}
+	if !guest.Guest {
+		c.JSON(http.StatusConflict, models.APIResponse{
+			Success: false,
+			Message: "Hesap zaten kayıtlı",
+		})
+		return
+	}
+
+	user, ok := newAccount(c, request)
+	if !ok {
+		return
+	}
+
+	// Misafirin tüm kayıtları tek işlemde yeni kullanıcı ID'sine taşınır
+	if err := h.Accounts.UpgradeGuest(ctx, guestID, &user); err != nil {
+		switch {
+		case errors.Is(err, store.ErrDuplicate):
+			c.JSON(http.StatusConflict, models.APIResponse{
+				Success: false,
+				Message: "Kullanıcı adı zaten alınmış",
+			})
+		case errors.Is(err, store.ErrNotFound):
+			c.JSON(http.StatusConflict, models.APIResponse{
+				Success: false,
+				Message: "Misafir hesabı zaten yükseltilmiş",
+			})
+		case errors.Is(err, store.ErrUnsupported):
+			c.JSON(http.StatusServiceUnavailable, models.APIResponse{
+				Success: false,
+				Message: "Misafir hesabı yükseltme bu sunucuda kullanılamıyor",
+				Error:   err.Error(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, models.APIResponse{
+				Success: false,
+				Message: "Hesap yükseltilemedi",
+				Error:   err.Error(),
+			})
+		}
+		return
+	}
+
+	h.respondWithToken(c, http.StatusOK, "Misafir hesabı kayıtlı hesaba yükseltildi", &user, "")
+}
+
+// newAccount request'ten şifresi özetlenmiş yeni kullanıcı oluşturur;
+// başarısız olursa hata yanıtını yazar
+func newAccount(c *gin.Context, request models.RegisterRequest) (models.User, bool) {
+	// Kullanıcı adları büyük/küçük harf duyarsızdır
+	username := strings.ToLower(strings.TrimSpace(request.Username))
+	if !usernamePattern.MatchString(username) {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Message: "Geçersiz kullanıcı adı",
+			Error:   "kullanıcı adı yalnızca harf, rakam, '_', '.' ve '-' içerebilir",
+		})
+		return models.User{}, false
+	}
+
+	// max=72 etiketi karakter sayar; çok byte'lı karakterlerle bcrypt sınırı aşılabilir
+	if n := len([]byte(request.Password)); n > auth.MaxPasswordBytes {
+		respondInvalid(c, "Geçersiz request formatı", []models.FieldError{{
+			Field:   "password",
+			Code:    "max",
+			Message: fmt.Sprintf("şifre en fazla %d byte olabilir (%d gönderildi)", auth.MaxPasswordBytes, n),
+		}})
+		return models.User{}, false
+	}
+
+	hash, err := auth.HashPassword(request.Password)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Hesap oluşturulamadı",
+			Error:   err.Error(),
+		})
+		return models.User{}, false
+	}
+
+	return models.User{
+		Username:     username,
+		DisplayName:  username,
+		PasswordHash: hash,
+		CreatedAt:    time.Now(),
+	}, true
+}
+
+// Login kullanıcı adı ve şifreyi doğrular ve oturum token'ı döndürür - POST /api/auth/login
+func (h *AuthHandler) Login(c *gin.Context) {
+	var request models.LoginRequest
+
+	// JSON request'i parse et
+	if !bindJSON(c, &request) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	username := strings.ToLower(strings.TrimSpace(request.Username))
+	user, err := h.Users.GetByUsername(ctx, username)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Giriş yapılamadı",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	// Kullanıcının olmadığı ile şifrenin yanlış olduğu ayırt edilmez
+	if user == nil || !auth.CheckPassword(user.PasswordHash, request.Password) {
+		c.JSON(http.StatusUnauthorized, models.APIResponse{
+			Success: false,
+			Message: "Geçersiz kullanıcı adı veya şifre",
+		})
+		return
+	}
+
+	h.respondWithToken(c, http.StatusOK, "Giriş başarılı", user, "")
+}
+
+// respondWithToken kullanıcı için token üretir ve AuthResponse ile yanıtlar.
+// deviceToken yalnızca yeni misafir oluşturulurken doludur.
+func (h *AuthHandler) respondWithToken(c *gin.Context, status int, message string, user *models.User, deviceToken string) {
+	token, expiresAt, err := h.Tokens.Issue(user.ID.Hex())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Oturum oluşturulamadı",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(status, models.APIResponse{
+		Success: true,
+		Message: message,
+		Data: models.AuthResponse{
+			Token:       token,
+			ExpiresAt:   expiresAt,
+			DeviceToken: deviceToken,
+			User:        *user,
+		},
+	})
+}
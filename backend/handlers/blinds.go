@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"balatro-backend/game/blinds"
+	"balatro-backend/game/rng"
+	"balatro-backend/game/stakes"
+	"balatro-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetBlinds bir ante'deki blind'ları hedef ve ödülleriyle döndürür - GET /api/blinds
+func GetBlinds(c *gin.Context) {
+	ante, err := strconv.Atoi(c.DefaultQuery("ante", "1"))
+	if err != nil || ante < 1 {
+		ante = 1
+	}
+
+	// Seed verildiyse boss blind'ı da belirle
+	seed := c.Query("seed")
+	if seed != "" {
+		if seed, err = rng.NormalizeSeed(seed); err != nil {
+			c.JSON(http.StatusBadRequest, models.APIResponse{
+				Success: false,
+				Message: "Geçersiz seed",
+				Error:   err.Error(),
+			})
+			return
+		}
+	}
+
+	// Stake verildiyse hedef ve ödüllere uygula
+	stake := c.Query("stake")
+	if stake != "" && !stakes.IsKnown(stake) {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Message: "Geçersiz stake",
+			Error:   stakes.ErrUnknownStake.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Blind bilgileri başarıyla yüklendi",
+		Data: map[string]interface{}{
+			"ante":   ante,
+			"blinds": blinds.ForAnte(seed, ante, stakes.Combined(stake)),
+		},
+	})
+}
+
+// CheckBlind skorun blind'ı geçip geçmediğini kontrol eder - POST /api/blinds/check
+func CheckBlind(c *gin.Context) {
+	var request models.CheckBlindRequest
+
+	// JSON request'i parse et
+	if !bindJSON(c, &request) {
+		return
+	}
+
+	kind := blinds.Kind(request.Kind)
+	if !blinds.IsValidKind(kind) || request.Ante < 1 {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Message: "Geçersiz blind",
+			Error:   "ante 1 veya üzeri, kind SMALL, BIG veya BOSS olmalı",
+		})
+		return
+	}
+
+	var boss *blinds.BossBlind
+	if request.Seed != "" {
+		seed, err := rng.NormalizeSeed(request.Seed)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, models.APIResponse{
+				Success: false,
+				Message: "Geçersiz seed",
+				Error:   err.Error(),
+			})
+			return
+		}
+		boss = blinds.BossForAnte(seed, request.Ante)
+	}
+
+	if request.Stake != "" && !stakes.IsKnown(request.Stake) {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Message: "Geçersiz stake",
+			Error:   stakes.ErrUnknownStake.Error(),
+		})
+		return
+	}
+	stake := stakes.Combined(request.Stake)
+
+	target := blinds.Target(request.Ante, kind, boss, stake)
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Blind kontrolü tamamlandı",
+		Data: map[string]interface{}{
+			"ante":    request.Ante,
+			"kind":    kind,
+			"target":  target,
+			"score":   request.Score,
+			"cleared": blinds.Cleared(request.Ante, kind, boss, stake, request.Score),
+		},
+	})
+}
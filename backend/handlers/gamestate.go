@@ -2,37 +2,62 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"time"
 
-	"balatro-backend/config"
+	"balatro-backend/game/planets"
+	"balatro-backend/game/rng"
+	"balatro-backend/game/tarots"
+	"balatro-backend/middleware"
+	"balatro-backend/migrations"
 	"balatro-backend/models"
+	"balatro-backend/store"
+	"balatro-backend/validation"
 
 	"github.com/gin-gonic/gin"
-	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// GameStateHandler oyun durumu endpoint'leri
+type GameStateHandler struct {
+	States store.PlayerStateStore
+}
+
+// NewGameStateHandler verilen depoyla oyun durumu handler'ı oluşturur
+func NewGameStateHandler(states store.PlayerStateStore) *GameStateHandler {
+	return &GameStateHandler{States: states}
+}
+
 // SaveGameState oyun durumunu kaydeder - POST /api/game-state
-func SaveGameState(c *gin.Context) {
+func (h *GameStateHandler) SaveGameState(c *gin.Context) {
 	var request models.CreatePlayerStateRequest
 
 	// JSON request'i parse et
-	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, models.APIResponse{
-			Success: false,
-			Message: "Geçersiz request formatı",
-			Error:   err.Error(),
-		})
+	if !bindJSON(c, &request) {
 		return
 	}
 
+	// Oyun kurallarını doğrula (kart sayıları, joker slotları, enhancement grupları);
+	// türler, değerler, katalog ID'leri ve aralıklar etiketlerle doğrulanmıştır
+	if errs := validation.PlayerState(&request); len(errs) > 0 {
+		respondInvalid(c, "Geçersiz oyun durumu", errs)
+		return
+	}
+	if request.TarotCardsInventory == nil {
+		request.TarotCardsInventory = []models.TarotCard{}
+	}
+	if request.VouchersOwned == nil {
+		request.VouchersOwned = []string{}
+	}
+
 	// Yeni PlayerState oluştur
 	playerState := models.PlayerState{
-		UserID:                request.UserID,
+		UserID:                middleware.UserID(c),
+		SchemaVersion:         migrations.PlayerStateVersion,
 		CurrentScore:          request.CurrentScore,
 		CurrentBlind:          request.CurrentBlind,
+		CurrentAnte:           request.CurrentAnte,
+		BlindKind:             request.BlindKind,
 		Money:                 request.Money,
 		Lives:                 request.Lives,
 		DiscardsLeft:          request.DiscardsLeft,
@@ -40,24 +65,37 @@ func SaveGameState(c *gin.Context) {
 		DeckCards:             request.DeckCards,
 		HandCards:             request.HandCards,
 		Jokers:                request.Jokers,
-		TarotCardsInventory:   []models.TarotCard{}, // Şimdilik boş
+		TarotCardsInventory:   request.TarotCardsInventory,
+		PlanetCardsInventory:  []models.PlanetCard{},
 		PlanetLevels:          request.PlanetLevels,
-		VouchersOwned:         []string{},           // Şimdilik boş
+		VouchersOwned:         request.VouchersOwned,
 		UnlockedContent:       map[string][]string{}, // Şimdilik boş
 		LastPlayedTimestamp:   time.Now(),
 	}
 
-	// MongoDB koleksiyonu
-	collection := config.GetCollection("player_states")
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	// Upsert işlemi (varsa güncelle, yoksa oluştur)
-	filter := bson.M{"userId": request.UserID}
-	update := bson.M{"$set": playerState}
-	opts := options.Update().SetUpsert(true)
+	// Tarot seed akışı ve planet envanteri istemciden gelmez; kayıtlı durumdan korunur
+	existing, err := h.States.Get(ctx, playerState.UserID)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Oyun durumu yüklenemedi",
+			Error:   err.Error(),
+		})
+		return
+	}
+	if existing != nil {
+		playerState.TarotSeed = existing.TarotSeed
+		playerState.TarotsUsed = existing.TarotsUsed
+		if existing.PlanetCardsInventory != nil {
+			playerState.PlanetCardsInventory = existing.PlanetCardsInventory
+		}
+	}
 
-	result, err := collection.UpdateOne(ctx, filter, update, opts)
+	// Upsert işlemi (varsa güncelle, yoksa oluştur)
+	result, err := h.States.Upsert(ctx, &playerState)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.APIResponse{
 			Success: false,
@@ -70,8 +108,8 @@ func SaveGameState(c *gin.Context) {
 	// Başarılı yanıt
 	responseData := map[string]interface{}{
 		"upsertedId": result.UpsertedID,
-		"modified":   result.ModifiedCount > 0,
-		"matched":    result.MatchedCount > 0,
+		"modified":   result.Modified,
+		"matched":    result.Matched,
 	}
 
 	c.JSON(http.StatusOK, models.APIResponse{
@@ -81,29 +119,18 @@ func SaveGameState(c *gin.Context) {
 	})
 }
 
-// LoadGameState oyun durumunu yükler - GET /api/game-state/:userId
-func LoadGameState(c *gin.Context) {
-	userID := c.Param("userId")
-	if userID == "" {
-		c.JSON(http.StatusBadRequest, models.APIResponse{
-			Success: false,
-			Message: "UserID parametresi gerekli",
-		})
-		return
-	}
+// LoadGameState oyun durumunu yükler - GET /api/game-state
+func (h *GameStateHandler) LoadGameState(c *gin.Context) {
+	// Oturumdaki kullanıcının durumu kullanılır
+	userID := middleware.UserID(c)
 
-	// MongoDB koleksiyonu
-	collection := config.GetCollection("player_states")
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	// Oyun durumunu bul
-	var playerState models.PlayerState
-	filter := bson.M{"userId": userID}
-
-	err := collection.FindOne(ctx, filter).Decode(&playerState)
+	playerState, err := h.States.Get(ctx, userID)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.Is(err, store.ErrNotFound) {
 			c.JSON(http.StatusNotFound, models.APIResponse{
 				Success: false,
 				Message: "Oyun durumu bulunamadı",
@@ -127,38 +154,101 @@ func LoadGameState(c *gin.Context) {
 	})
 }
 
-// DeleteGameState oyun durumunu siler - DELETE /api/game-state/:userId
-func DeleteGameState(c *gin.Context) {
-	userID := c.Param("userId")
-	if userID == "" {
-		c.JSON(http.StatusBadRequest, models.APIResponse{
+// DeleteGameState oyun durumunu siler - DELETE /api/game-state
+func (h *GameStateHandler) DeleteGameState(c *gin.Context) {
+	// Oturumdaki kullanıcının durumu kullanılır
+	userID := middleware.UserID(c)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Oyun durumunu sil
+	if err := h.States.Delete(ctx, userID); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			c.JSON(http.StatusNotFound, models.APIResponse{
+				Success: false,
+				Message: "Silinecek oyun durumu bulunamadı",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
 			Success: false,
-			Message: "UserID parametresi gerekli",
+			Message: "Oyun durumu silinemedi",
+			Error:   err.Error(),
 		})
 		return
 	}
 
-	// MongoDB koleksiyonu
-	collection := config.GetCollection("player_states")
+	// Başarılı yanıt
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Oyun durumu başarıyla silindi",
+		Data: map[string]int64{
+			"deletedCount": 1,
+		},
+	})
+}
+
+// UseTarot envanterdeki tarot kartını kullanır - POST /api/game-state/tarot/use
+func (h *GameStateHandler) UseTarot(c *gin.Context) {
+	// Oturumdaki kullanıcının durumu kullanılır
+	userID := middleware.UserID(c)
+
+	var request models.UseTarotRequest
+	if !bindJSON(c, &request) {
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	// Oyun durumunu sil
-	filter := bson.M{"userId": userID}
-	result, err := collection.DeleteOne(ctx, filter)
+	// Oyun durumunu bul
+	playerState, err := h.States.Get(ctx, userID)
 	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			c.JSON(http.StatusNotFound, models.APIResponse{
+				Success: false,
+				Message: "Oyun durumu bulunamadı",
+			})
+			return
+		}
+
 		c.JSON(http.StatusInternalServerError, models.APIResponse{
 			Success: false,
-			Message: "Oyun durumu silinemedi",
+			Message: "Oyun durumu yüklenemedi",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	// Tarot efektini durumun seed'inden türetilen RNG ile uygula; seed ilk
+	// kullanımda üretilir ve her tarot kendi akışını kullanır
+	if playerState.TarotSeed == "" {
+		playerState.TarotSeed = rng.NewSeed()
+	}
+	r := rng.ForTarot(playerState.TarotSeed, playerState.TarotsUsed)
+	if err := tarots.Use(playerState, request.TarotID, request.Targets, r); err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, tarots.ErrNoEffect) || errors.Is(err, tarots.ErrJokersRequired) {
+			status = http.StatusConflict
+		}
+		c.JSON(status, models.APIResponse{
+			Success: false,
+			Message: "Tarot kartı kullanılamadı",
 			Error:   err.Error(),
 		})
 		return
 	}
 
-	if result.DeletedCount == 0 {
-		c.JSON(http.StatusNotFound, models.APIResponse{
+	// Değişen durumu kaydet
+	playerState.TarotsUsed++
+	playerState.LastPlayedTimestamp = time.Now()
+	if _, err := h.States.Upsert(ctx, playerState); err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
 			Success: false,
-			Message: "Silinecek oyun durumu bulunamadı",
+			Message: "Oyun durumu kaydedilemedi",
+			Error:   err.Error(),
 		})
 		return
 	}
@@ -166,9 +256,73 @@ func DeleteGameState(c *gin.Context) {
 	// Başarılı yanıt
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
-		Message: "Oyun durumu başarıyla silindi",
-		Data: map[string]int64{
-			"deletedCount": result.DeletedCount,
+		Message: "Tarot kartı başarıyla kullanıldı",
+		Data:    playerState,
+	})
+}
+
+// UsePlanet envanterdeki planet kartını kullanarak el türünün seviyesini artırır - POST /api/game-state/planet/use
+func (h *GameStateHandler) UsePlanet(c *gin.Context) {
+	// Oturumdaki kullanıcının durumu kullanılır
+	userID := middleware.UserID(c)
+
+	var request models.UsePlanetRequest
+	if !bindJSON(c, &request) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Oyun durumunu bul
+	playerState, err := h.States.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			c.JSON(http.StatusNotFound, models.APIResponse{
+				Success: false,
+				Message: "Oyun durumu bulunamadı",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Oyun durumu yüklenemedi",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	// Envanterdeki planet kartını kullanıp seviyesini artır
+	level, err := planets.UseFromInventory(playerState, request.PlanetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Message: "Planet kartı kullanılamadı",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	// Değişen durumu kaydet
+	playerState.LastPlayedTimestamp = time.Now()
+	if _, err := h.States.Upsert(ctx, playerState); err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Oyun durumu kaydedilemedi",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	// Başarılı yanıt: yeni seviye ve taban değerler
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Planet kartı başarıyla kullanıldı",
+		Data: map[string]interface{}{
+			"hand":                 level,
+			"planetLevels":         playerState.PlanetLevels,
+			"planetCardsInventory": playerState.PlanetCardsInventory,
 		},
 	})
-}
\ No newline at end of file
+}
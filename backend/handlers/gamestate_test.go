@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"balatro-backend/models"
+)
+
+func TestSaveGameStateKeepsServerOwnedFields(t *testing.T) {
+	s := newTestServer(t)
+	userID, token := s.login(t, "ada")
+	ctx := context.Background()
+
+	// Tarot seed akışı ve planet envanteri yalnızca sunucuda değişir
+	stored := &models.PlayerState{
+		UserID:               userID,
+		TarotSeed:            "TAROTS",
+		TarotsUsed:           2,
+		PlanetCardsInventory: []models.PlanetCard{{ID: "planet_mercury", Quantity: 1}},
+	}
+	if _, err := s.stores.PlayerStates.Upsert(ctx, stored); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+
+	body := map[string]interface{}{
+		"money":                50,
+		"lives":                3,
+		"planetCardsInventory": []map[string]interface{}{{"id": "planet_jupiter", "quantity": 9}},
+	}
+	if code, response := s.do(t, http.MethodPost, "/api/game-state", token, body); code != http.StatusOK {
+		t.Fatalf("save: %d %+v", code, response)
+	}
+
+	state, err := s.stores.PlayerStates.Get(ctx, userID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if state.Money != 50 || state.Lives != 3 {
+		t.Errorf("money/lives = %d/%d, want 50/3", state.Money, state.Lives)
+	}
+	if state.TarotSeed != "TAROTS" || state.TarotsUsed != 2 {
+		t.Errorf("tarot stream = %q/%d, want TAROTS/2", state.TarotSeed, state.TarotsUsed)
+	}
+	if len(state.PlanetCardsInventory) != 1 || state.PlanetCardsInventory[0].ID != "planet_mercury" {
+		t.Errorf("planet inventory = %+v, want the stored mercury card", state.PlanetCardsInventory)
+	}
+}
+
+func TestSaveGameStateRejectsInvalidState(t *testing.T) {
+	ace := map[string]interface{}{"suit": "SPADES", "value": "ACE", "enhancements": []string{}}
+	tests := []struct {
+		name  string
+		body  map[string]interface{}
+		field string
+		code  string
+	}{
+		{
+			name:  "unknown suit",
+			body:  map[string]interface{}{"deckCards": []map[string]interface{}{{"suit": "STARS", "value": "ACE"}}},
+			field: "deckCards[0].suit",
+			code:  "suit",
+		},
+		{
+			name:  "negative money",
+			body:  map[string]interface{}{"money": -1},
+			field: "money",
+			code:  "min",
+		},
+		{
+			name:  "too many identical cards",
+			body:  map[string]interface{}{"deckCards": []interface{}{ace, ace, ace, ace, ace}},
+			field: "deckCards[4]",
+			code:  "duplicate_card",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			userID, token := s.login(t, "ada")
+
+			code, response := s.do(t, http.MethodPost, "/api/game-state", token, tt.body)
+			if code != http.StatusBadRequest || !hasFieldError(response, tt.field, tt.code) {
+				t.Fatalf("save = %d %+v; want 400 with %s/%s", code, response.Errors, tt.field, tt.code)
+			}
+			if _, err := s.stores.PlayerStates.Get(context.Background(), userID); err == nil {
+				t.Error("invalid state was stored")
+			}
+		})
+	}
+}
+
+func TestLoadGameState(t *testing.T) {
+	s := newTestServer(t)
+	_, token := s.login(t, "ada")
+
+	if code, _ := s.do(t, http.MethodGet, "/api/game-state", "", nil); code != http.StatusUnauthorized {
+		t.Errorf("without token: %d, want 401", code)
+	}
+	if code, _ := s.do(t, http.MethodGet, "/api/game-state", token, nil); code != http.StatusNotFound {
+		t.Errorf("before save: %d, want 404", code)
+	}
+	if code, response := s.do(t, http.MethodPost, "/api/game-state", token, map[string]interface{}{"money": 7}); code != http.StatusOK {
+		t.Fatalf("save: %d %+v", code, response)
+	}
+	code, response := s.do(t, http.MethodGet, "/api/game-state", token, nil)
+	if code != http.StatusOK {
+		t.Fatalf("load: %d %+v", code, response)
+	}
+	if data, ok := response.Data.(map[string]interface{}); !ok || data["money"] != float64(7) {
+		t.Errorf("loaded data = %+v, want money 7", response.Data)
+	}
+}
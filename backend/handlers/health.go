@@ -1,22 +1,42 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 	"time"
 
-	"balatro-backend/config"
+	"balatro-backend/game/cards"
+	"balatro-backend/game/decks"
+	"balatro-backend/game/hands"
+	"balatro-backend/game/planets"
+	"balatro-backend/game/stakes"
+	"balatro-backend/game/vouchers"
 	"balatro-backend/models"
+	"balatro-backend/store"
 
 	"github.com/gin-gonic/gin"
 )
 
+// HealthHandler sağlık kontrolü endpoint'i
+type HealthHandler struct {
+	DB store.Pinger
+}
+
+// NewHealthHandler verilen depolamayı kontrol eden sağlık handler'ı oluşturur
+func NewHealthHandler(db store.Pinger) *HealthHandler {
+	return &HealthHandler{DB: db}
+}
+
 // HealthCheck API sağlık durumu kontrolü - GET /api/health
-func HealthCheck(c *gin.Context) {
+func (h *HealthHandler) HealthCheck(c *gin.Context) {
 	// Veritabanı bağlantısını kontrol et
 	dbStatus := "healthy"
 	dbError := ""
 
-	if err := config.HealthCheck(); err != nil {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := h.DB.Ping(ctx); err != nil {
 		dbStatus = "unhealthy"
 		dbError = err.Error()
 	}
@@ -57,22 +77,75 @@ func GetAPIInfo(c *gin.Context) {
 		"name":        "Balatro Game Backend API",
 		"version":     "1.0.0",
 		"description": "Balatro tarzı kart oyunu için backend API",
+		"authentication": "Oturum gerektiren endpoint'ler için Authorization: Bearer <token> başlığı gönderilmelidir",
 		"endpoints": map[string]interface{}{
+			"auth": []string{
+				"POST /api/auth/register - Hesap oluştur (token döner)",
+				"POST /api/auth/login - Giriş yap (token döner)",
+				"POST /api/auth/guest - Misafir oturumu (cihaz token'ı yoksa yeni misafir oluşturur)",
+				"POST /api/auth/upgrade - Misafir hesabını kayıtlı hesaba yükselt, kayıtlar taşınır (oturum gerekli)",
+			},
 			"gameState": []string{
-				"POST /api/game-state - Oyun durumu kaydet",
-				"GET /api/game-state/:userId - Oyun durumu yükle",
-				"DELETE /api/game-state/:userId - Oyun durumu sil",
+				"POST /api/game-state - Oyun durumu kaydet (oturum gerekli)",
+				"GET /api/game-state - Oyun durumu yükle (oturum gerekli)",
+				"DELETE /api/game-state - Oyun durumu sil (oturum gerekli)",
+				"POST /api/game-state/tarot/use - Tarot kartı kullan (oturum gerekli)",
+				"POST /api/game-state/planet/use - Planet kartı kullan (oturum gerekli)",
 			},
 			"highscores": []string{
-				"POST /api/highscores - Yüksek skor kaydet",
-				"GET /api/highscores - Yüksek skorları listele",
+				"POST /api/highscores - Yüksek skor kaydet (oturum gerekli, seed + aksiyon kaydı ile doğrulanır)",
+				"GET /api/highscores?deck=D&stake=S - Yüksek skorları listele (opsiyonel deste/stake filtresi, işaretli/silinmiş/yasaklı skorlar gizlenir)",
 				"GET /api/highscores/user/:userId - Kullanıcı yüksek skoru",
 			},
+			"profile": []string{
+				"GET /api/profile/:userId/collection - Keşfedilmiş ve kilitli öğeler",
+			},
+			"runs": []string{
+				"POST /api/runs - Yeni run başlat (oturum gerekli, opsiyonel seed, deste ve stake)",
+				"GET /api/runs/:id - Run durumunu yükle",
+				"POST /api/runs/:id/play - Seçilen kartları oyna",
+				"POST /api/runs/:id/discard - Seçilen kartları at",
+				"POST /api/runs/:id/shop/buy - Dükkandan satın al",
+				"POST /api/runs/:id/shop/reroll - Dükkanı yenile",
+				"POST /api/runs/:id/packs/open - Satın alınmış paketi aç",
+				"POST /api/runs/:id/packs/pick - Açık paketten seçim yap",
+				"POST /api/runs/:id/tarot/use - Run'daki tarot kartını kullan",
+				"POST /api/runs/:id/advance - Sonraki faza geç",
+			},
+			"admin": []string{
+				"GET /api/admin/highscores/suspicious - Şüpheli skorlar (imkansız skor, fazla/bilinmeyen joker, tekrar)",
+				"POST /api/admin/highscores/:id/moderate - Skoru işaretle, işareti kaldır, sil veya geri getir",
+				"GET /api/admin/bans - Liderlik tablosu yasakları",
+				"POST /api/admin/bans/:userId - Kullanıcıyı liderlik tablolarından yasakla",
+				"DELETE /api/admin/bans/:userId - Yasağı kaldır",
+				"GET /api/admin/audit-log - Admin işlem kaydı",
+			},
+			"blinds": []string{
+				"GET /api/blinds?ante=N&seed=S&stake=K - Ante'deki blind'lar ve hedefleri",
+				"POST /api/blinds/check - Skorun blind'ı geçip geçmediğini kontrol et",
+			},
+			"score": []string{
+				"POST /api/score/calculate - El puanını adım adım hesapla",
+			},
+			"seed": []string{
+				"GET /api/seed/:seed/preview?count=N&round=R - Seed'in ilk N kart çekimi",
+			},
 			"system": []string{
 				"GET /api/health - Sistem sağlık durumu",
 				"GET /api/info - API bilgileri",
 			},
 		},
+		// El türlerinin temel değerleri ve planet seviye artışları (skorlama ile aynı tablo)
+		"hands":    hands.All(),
+		"planets":  planets.All(),
+		"vouchers": vouchers.All(),
+		"decks":    decks.All(),
+		"stakes":   stakes.All(),
+		"cardModifiers": map[string]interface{}{
+			"enhancements": cards.Enhancements,
+			"editions":     cards.Editions,
+			"seals":        cards.Seals,
+		},
 		"timestamp": time.Now(),
 	}
 
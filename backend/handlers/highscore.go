@@ -2,50 +2,80 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strconv"
 	"time"
 
-	"balatro-backend/config"
+	"balatro-backend/game/replay"
+	"balatro-backend/middleware"
 	"balatro-backend/models"
+	"balatro-backend/store"
 
 	"github.com/gin-gonic/gin"
-	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// HighscoreHandler yüksek skor endpoint'leri
+type HighscoreHandler struct {
+	Highscores store.HighscoreStore
+	Users      store.UserStore
+	Moderation store.ModerationStore
+	Runs       store.RunStore
+}
+
+// NewHighscoreHandler verilen depolarla yüksek skor handler'ı oluşturur
+func NewHighscoreHandler(highscores store.HighscoreStore, users store.UserStore, moderation store.ModerationStore, runs store.RunStore) *HighscoreHandler {
+	return &HighscoreHandler{Highscores: highscores, Users: users, Moderation: moderation, Runs: runs}
+}
+
 // SaveHighscore yüksek skor kaydeder - POST /api/highscores
-func SaveHighscore(c *gin.Context) {
+func (h *HighscoreHandler) SaveHighscore(c *gin.Context) {
 	var request models.CreateHighscoreRequest
 
 	// JSON request'i parse et
-	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, models.APIResponse{
-			Success: false,
-			Message: "Geçersiz request formatı",
-			Error:   err.Error(),
-		})
+	if !bindJSON(c, &request) {
 		return
 	}
 
-	// Yeni Highscore oluştur
+	// Oyuncu adı hesaptan tamamlanır
+	if !h.resolvePlayerName(c, &request) {
+		return
+	}
+
+	// Seed, deste, stake ve kilitler istemciden değil, run oluşturulurken
+	// sunucuda kaydedilen değerlerden alınır
+	current, ok := findRun(c, h.Runs, request.RunID)
+	if !ok {
+		return
+	}
+
+	// Run'ı seed ve aksiyon kaydından yeniden oynatarak doğrula (dükkan run
+	// başındaki kilitlerle üretilir)
+	setup := replay.Setup{Seed: current.Seed, Deck: current.DeckID, Stake: current.StakeID, Locked: current.Locked}
+	verdict := replay.Verify(setup, request.Actions, request.Score, request.FinalBlind)
+	if !verdict.Accepted {
+		h.rejectHighscore(c, request, current, verdict)
+		return
+	}
+
+	// Yeni Highscore oluştur (jokerler yeniden oynatmadan alınır)
 	highscore := models.Highscore{
-		UserID:       request.UserID,
+		UserID:       middleware.UserID(c),
 		PlayerName:   request.PlayerName,
-		Score:        request.Score,
+		Score:        verdict.Result.Score,
 		DateAchieved: time.Now(),
-		FinalBlind:   request.FinalBlind,
-		JokersUsed:   request.JokersUsed,
-		Seed:         request.Seed,
+		FinalBlind:   verdict.Result.FinalBlind,
+		JokersUsed:   verdict.Result.JokersUsed,
+		Seed:         verdict.Result.Run.Seed,
+		Deck:         verdict.Result.Run.DeckID,
+		Stake:        verdict.Result.Run.StakeID,
 	}
 
-	// MongoDB koleksiyonu
-	collection := config.GetCollection("highscores")
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	// Highscore'u kaydet
-	result, err := collection.InsertOne(ctx, highscore)
+	// Yasaklı kullanıcının yeni skorları da liderlik tablolarında görünmez
+	banned, err := h.Moderation.IsBanned(ctx, highscore.UserID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.APIResponse{
 			Success: false,
@@ -54,24 +84,110 @@ func SaveHighscore(c *gin.Context) {
 		})
 		return
 	}
+	highscore.Banned = banned
+
+	// Highscore'u kaydet
+	if err := h.Highscores.Insert(ctx, &highscore); err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Yüksek skor kaydedilemedi",
+			Error:   err.Error(),
+		})
+		return
+	}
 
 	// Başarılı yanıt
 	c.JSON(http.StatusCreated, models.APIResponse{
 		Success: true,
 		Message: "Yüksek skor başarıyla kaydedildi",
 		Data: map[string]interface{}{
-			"insertedId": result.InsertedID,
+			"insertedId": highscore.ID,
 			"score":      highscore.Score,
 		},
 	})
 }
 
+// resolvePlayerName misafirler için üretilmiş görünen adı, kayıtlı kullanıcılar
+// için boşsa görünen adı kullanır; başarısız olursa hata yanıtını yazar
+func (h *HighscoreHandler) resolvePlayerName(c *gin.Context, request *models.CreateHighscoreRequest) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	user, err := h.Users.GetByID(ctx, middleware.UserID(c))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, store.ErrNotFound) {
+			status = http.StatusUnauthorized
+		}
+		c.JSON(status, models.APIResponse{
+			Success: false,
+			Message: "Hesap bulunamadı",
+			Error:   err.Error(),
+		})
+		return false
+	}
+
+	if user.Guest || request.PlayerName == "" {
+		request.PlayerName = user.DisplayName
+	}
+	if request.PlayerName == "" {
+		request.PlayerName = user.Username
+	}
+	return true
+}
+
+// rejectHighscore doğrulanamayan gönderimi moderasyon için kaydeder ve reddeder
+func (h *HighscoreHandler) rejectHighscore(c *gin.Context, request models.CreateHighscoreRequest, current *models.Run, verdict replay.Verdict) {
+	rejected := models.RejectedHighscore{
+		UserID:            middleware.UserID(c),
+		PlayerName:        request.PlayerName,
+		ClaimedScore:      request.Score,
+		ClaimedFinalBlind: request.FinalBlind,
+		RunID:             request.RunID,
+		Seed:              current.Seed,
+		Deck:              current.DeckID,
+		Stake:             current.StakeID,
+		Actions:           request.Actions,
+		Reason:            verdict.Reason,
+		Detail:            verdict.Detail,
+		SubmittedAt:       time.Now(),
+	}
+	if verdict.Result != nil {
+		rejected.ComputedScore = verdict.Result.Score
+		rejected.ComputedFinalBlind = verdict.Result.FinalBlind
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := h.Highscores.InsertRejected(ctx, &rejected); err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Yüksek skor kaydedilemedi",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusUnprocessableEntity, models.APIResponse{
+		Success: false,
+		Message: "Yüksek skor doğrulanamadı",
+		Data: map[string]interface{}{
+			"rejectedId": rejected.ID,
+			"reason":     verdict.Reason,
+		},
+		Error: verdict.Detail,
+	})
+}
+
 // GetHighscores yüksek skorları döndürür - GET /api/highscores
-func GetHighscores(c *gin.Context) {
+func (h *HighscoreHandler) GetHighscores(c *gin.Context) {
 	// Query parametreleri
 	limitStr := c.DefaultQuery("limit", "10")
 	offsetStr := c.DefaultQuery("offset", "0")
 	userID := c.Query("userId") // Opsiyonel: belirli bir kullanıcının skorları
+	deck := c.Query("deck")     // Opsiyonel: belirli bir destenin skorları
+	stake := c.Query("stake")   // Opsiyonel: belirli bir stake'in skorları
 
 	// String'leri int'e çevir
 	limit, err := strconv.Atoi(limitStr)
@@ -84,25 +200,14 @@ func GetHighscores(c *gin.Context) {
 		offset = 0
 	}
 
-	// MongoDB koleksiyonu
-	collection := config.GetCollection("highscores")
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	// Filter oluştur
-	filter := bson.M{}
-	if userID != "" {
-		filter["userId"] = userID
-	}
+	// İşaretlenmiş, silinmiş ve yasaklı kullanıcılara ait skorlar gizlenir
+	filter := store.HighscoreFilter{UserID: userID, Deck: deck, Stake: stake, VisibleOnly: true}
 
-	// Sıralama ve limit options
-	opts := options.Find().
-		SetSort(bson.D{{"score", -1}, {"dateAchieved", -1}}). // Skor azalan, tarih azalan
-		SetLimit(int64(limit)).
-		SetSkip(int64(offset))
-
-	// Highscore'ları bul
-	cursor, err := collection.Find(ctx, filter, opts)
+	// Highscore'ları bul (skor azalan, tarih azalan)
+	highscores, totalCount, err := h.Highscores.List(ctx, filter, limit, offset)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.APIResponse{
 			Success: false,
@@ -111,24 +216,6 @@ func GetHighscores(c *gin.Context) {
 		})
 		return
 	}
-	defer cursor.Close(ctx)
-
-	// Sonuçları decode et
-	var highscores []models.Highscore
-	if err = cursor.All(ctx, &highscores); err != nil {
-		c.JSON(http.StatusInternalServerError, models.APIResponse{
-			Success: false,
-			Message: "Yüksek skorlar işlenemedi",
-			Error:   err.Error(),
-		})
-		return
-	}
-
-	// Toplam sayı (pagination için)
-	totalCount, err := collection.CountDocuments(ctx, filter)
-	if err != nil {
-		totalCount = int64(len(highscores)) // Fallback
-	}
 
 	// Başarılı yanıt
 	responseData := map[string]interface{}{
@@ -147,7 +234,7 @@ func GetHighscores(c *gin.Context) {
 }
 
 // GetUserHighscore belirli bir kullanıcının en yüksek skorunu döndürür - GET /api/highscores/user/:userId
-func GetUserHighscore(c *gin.Context) {
+func (h *HighscoreHandler) GetUserHighscore(c *gin.Context) {
 	userID := c.Param("userId")
 	if userID == "" {
 		c.JSON(http.StatusBadRequest, models.APIResponse{
@@ -157,19 +244,13 @@ func GetUserHighscore(c *gin.Context) {
 		return
 	}
 
-	// MongoDB koleksiyonu
-	collection := config.GetCollection("highscores")
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	// En yüksek skoru bul
-	filter := bson.M{"userId": userID}
-	opts := options.FindOne().SetSort(bson.D{{"score", -1}})
-
-	var highscore models.Highscore
-	err := collection.FindOne(ctx, filter, opts).Decode(&highscore)
+	// En yüksek görünür skoru bul (yasaklı kullanıcıların skorları görünmez)
+	highscore, err := h.Highscores.Best(ctx, userID)
 	if err != nil {
-		if err.Error() == "mongo: no documents in result" {
+		if errors.Is(err, store.ErrNotFound) {
 			c.JSON(http.StatusNotFound, models.APIResponse{
 				Success: false,
 				Message: "Kullanıcının yüksek skoru bulunamadı",
@@ -185,8 +266,8 @@ func GetUserHighscore(c *gin.Context) {
 		return
 	}
 
-	// Kullanıcının tüm skorlarındaki sıralamasını bul
-	higherScoresCount, _ := collection.CountDocuments(ctx, bson.M{"score": bson.M{"$gt": highscore.Score}})
+	// Kullanıcının görünür skorlar arasındaki sıralamasını bul
+	higherScoresCount, _ := h.Highscores.CountAbove(ctx, store.HighscoreFilter{VisibleOnly: true}, highscore.Score)
 	rank := higherScoresCount + 1
 
 	// Başarılı yanıt
@@ -200,4 +281,4 @@ func GetUserHighscore(c *gin.Context) {
 		Message: "Kullanıcı yüksek skoru başarıyla yüklendi",
 		Data:    responseData,
 	})
-}
\ No newline at end of file
+}
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"balatro-backend/game/replay"
+	"balatro-backend/game/run"
+	"balatro-backend/models"
+	"balatro-backend/store"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// newRun kullanıcı için kayıtlı bir run oluşturur
+func newRun(t *testing.T, s *testServer, userID, seed string) *models.Run {
+	t.Helper()
+	current, err := run.New(userID, seed, "red", "white")
+	if err != nil {
+		t.Fatalf("run.New: %v", err)
+	}
+	if err := s.stores.Runs.Create(context.Background(), current); err != nil {
+		t.Fatalf("Create run: %v", err)
+	}
+	return current
+}
+
+// firstHand blind'ı başlatıp ilk beş kartı oynayan aksiyon kaydı
+var firstHand = []models.ReplayAction{
+	{Type: replay.ActionAdvance},
+	{Type: replay.ActionPlay, Cards: []int{0, 1, 2, 3, 4}},
+}
+
+func TestSaveHighscoreReplaysStoredRun(t *testing.T) {
+	s := newTestServer(t)
+	userID, token := s.login(t, "ada")
+	current := newRun(t, s, userID, "HANDLERS")
+
+	result, err := replay.Simulate(replay.Setup{Seed: current.Seed, Deck: current.DeckID, Stake: current.StakeID}, firstHand)
+	if err != nil {
+		t.Fatalf("Simulate: %v", err)
+	}
+
+	// Sunucunun hesapladığından yüksek skor reddedilir ve moderasyon için saklanır
+	inflated := map[string]interface{}{
+		"runId":      current.ID.Hex(),
+		"score":      result.Score + 1,
+		"finalBlind": result.FinalBlind,
+		"actions":    firstHand,
+	}
+	code, response := s.do(t, http.MethodPost, "/api/highscores", token, inflated)
+	if code != http.StatusUnprocessableEntity {
+		t.Fatalf("inflated score: %d %+v, want 422", code, response)
+	}
+	if data, _ := response.Data.(map[string]interface{}); data["reason"] != replay.ReasonScoreMismatch {
+		t.Errorf("inflated score: reason = %v, want %q", data["reason"], replay.ReasonScoreMismatch)
+	}
+
+	honest := map[string]interface{}{
+		"runId":      current.ID.Hex(),
+		"score":      result.Score,
+		"finalBlind": result.FinalBlind,
+		"actions":    firstHand,
+	}
+	if code, response := s.do(t, http.MethodPost, "/api/highscores", token, honest); code != http.StatusCreated {
+		t.Fatalf("honest score: %d %+v, want 201", code, response)
+	}
+
+	scores, total, err := s.stores.Highscores.List(context.Background(), store.HighscoreFilter{}, 10, 0)
+	if err != nil || total != 1 {
+		t.Fatalf("List = %d scores, %v; want 1", total, err)
+	}
+	if got := scores[0]; got.Score != result.Score || got.Seed != current.Seed || got.PlayerName != "ada" {
+		t.Errorf("stored = %+v; want score %d from seed %s by ada", got, result.Score, current.Seed)
+	}
+}
+
+func TestSaveHighscoreRequiresOwnRun(t *testing.T) {
+	s := newTestServer(t)
+	_, token := s.login(t, "ada")
+	otherID, _ := s.login(t, "grace")
+	other := newRun(t, s, otherID, "OTHERS")
+
+	tests := []struct {
+		name  string
+		runID string
+		want  int
+	}{
+		{"missing run id", "", http.StatusBadRequest},
+		{"malformed run id", "not-an-id", http.StatusBadRequest},
+		{"unknown run", primitive.NewObjectID().Hex(), http.StatusNotFound},
+		{"another user's run", other.ID.Hex(), http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := map[string]interface{}{"runId": tt.runID, "score": 100, "actions": firstHand}
+			if code, response := s.do(t, http.MethodPost, "/api/highscores", token, body); code != tt.want {
+				t.Errorf("save = %d %+v, want %d", code, response, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetHighscoresHidesBannedUsers(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	honestID, _ := s.login(t, "ada")
+	bannedID, _ := s.login(t, "mallory")
+
+	for _, highscore := range []models.Highscore{
+		{UserID: honestID, PlayerName: "ada", Score: 500},
+		{UserID: bannedID, PlayerName: "mallory", Score: 9000},
+	} {
+		highscore := highscore
+		if err := s.stores.Highscores.Insert(ctx, &highscore); err != nil {
+			t.Fatalf("Insert: %v", err)
+		}
+	}
+	if code, response := s.do(t, http.MethodPost, "/api/admin/bans/"+bannedID, "", map[string]string{"reason": "cheating"}); code != http.StatusOK {
+		t.Fatalf("ban: %d %+v", code, response)
+	}
+
+	code, response := s.do(t, http.MethodGet, "/api/highscores", "", nil)
+	if code != http.StatusOK {
+		t.Fatalf("list: %d %+v", code, response)
+	}
+	data, _ := response.Data.(map[string]interface{})
+	list, _ := data["highscores"].([]interface{})
+	if data["totalCount"] != float64(1) || len(list) != 1 || list[0].(map[string]interface{})["userId"] != honestID {
+		t.Errorf("list = %+v; want only ada's score", data)
+	}
+
+	if code, _ := s.do(t, http.MethodGet, "/api/highscores/user/"+bannedID, "", nil); code != http.StatusNotFound {
+		t.Errorf("banned user's best: %d, want 404", code)
+	}
+	code, response = s.do(t, http.MethodGet, "/api/highscores/user/"+honestID, "", nil)
+	if data, _ := response.Data.(map[string]interface{}); code != http.StatusOK || data["rank"] != float64(1) {
+		t.Errorf("honest user's best = %d %+v; want rank 1", code, response.Data)
+	}
+
+	// Yasak kalkınca skorlar yeniden görünür
+	if code, response := s.do(t, http.MethodDelete, "/api/admin/bans/"+bannedID, "", nil); code != http.StatusOK {
+		t.Fatalf("unban: %d %+v", code, response)
+	}
+	code, response = s.do(t, http.MethodGet, "/api/highscores", "", nil)
+	if data, _ := response.Data.(map[string]interface{}); code != http.StatusOK || data["totalCount"] != float64(2) {
+		t.Errorf("after unban = %d %+v; want both scores", code, response.Data)
+	}
+}
+
+func TestSaveHighscoreHidesBannedUsersScores(t *testing.T) {
+	s := newTestServer(t)
+	userID, token := s.login(t, "mallory")
+	current := newRun(t, s, userID, "BANNED")
+	if code, response := s.do(t, http.MethodPost, "/api/admin/bans/"+userID, "", map[string]string{"reason": "cheating"}); code != http.StatusOK {
+		t.Fatalf("ban: %d %+v", code, response)
+	}
+
+	result, err := replay.Simulate(replay.Setup{Seed: current.Seed, Deck: current.DeckID, Stake: current.StakeID}, firstHand)
+	if err != nil {
+		t.Fatalf("Simulate: %v", err)
+	}
+	body := map[string]interface{}{"runId": current.ID.Hex(), "score": result.Score, "finalBlind": result.FinalBlind, "actions": firstHand}
+	if code, response := s.do(t, http.MethodPost, "/api/highscores", token, body); code != http.StatusCreated {
+		t.Fatalf("save: %d %+v", code, response)
+	}
+
+	scores, _, err := s.stores.Highscores.List(context.Background(), store.HighscoreFilter{UserID: userID}, 10, 0)
+	if err != nil || len(scores) != 1 || !scores[0].Banned {
+		t.Fatalf("stored = %+v, %v; want one banned score", scores, err)
+	}
+}
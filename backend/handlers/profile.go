@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"balatro-backend/game/unlocks"
+	"balatro-backend/models"
+	"balatro-backend/store"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProfileHandler profil endpoint'leri
+type ProfileHandler struct {
+	Profiles store.ProfileStore
+}
+
+// NewProfileHandler verilen depoyla profil handler'ı oluşturur
+func NewProfileHandler(profiles store.ProfileStore) *ProfileHandler {
+	return &ProfileHandler{Profiles: profiles}
+}
+
+// GetCollection kullanıcının keşfedilmiş ve kilitli öğelerini döndürür - GET /api/profile/:userId/collection
+func (h *ProfileHandler) GetCollection(c *gin.Context) {
+	userID := c.Param("userId")
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Message: "UserID parametresi gerekli",
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	profile, err := loadProfile(ctx, h.Profiles, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Profil yüklenemedi",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	// Başarılı yanıt
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Koleksiyon başarıyla yüklendi",
+		Data: map[string]interface{}{
+			"userId":     profile.UserID,
+			"stats":      profile.Stats,
+			"collection": unlocks.Collection(profile),
+			"rules":      unlocks.Rules(),
+		},
+	})
+}
+
+// loadProfile kullanıcının profilini yükler; yoksa varsayılan profili döndürür
+func loadProfile(ctx context.Context, profiles store.ProfileStore, userID string) (*models.Profile, error) {
+	profile, err := profiles.Get(ctx, userID)
+	if errors.Is(err, store.ErrNotFound) {
+		return unlocks.NewProfile(userID), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// recordRunEnd biten run'ı kullanıcının profiline işler ve yeni açılan kuralları döndürür.
+// Profil hatası run'ı etkilemez, yalnızca loglanır.
+func recordRunEnd(profiles store.ProfileStore, current *models.Run, won bool) []unlocks.Rule {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	profile, err := loadProfile(ctx, profiles, current.UserID)
+	if err != nil {
+		log.Printf("⚠️ Profil yüklenemedi (%s): %v", current.UserID, err)
+		return nil
+	}
+
+	unlocked := unlocks.Evaluate(profile, unlocks.Summarize(current, won))
+
+	// Upsert işlemi (varsa güncelle, yoksa oluştur)
+	if err := profiles.Save(ctx, profile); err != nil {
+		log.Printf("⚠️ Profil kaydedilemedi (%s): %v", current.UserID, err)
+		return nil
+	}
+	return unlocked
+}
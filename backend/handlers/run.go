@@ -0,0 +1,542 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"balatro-backend/game/decks"
+	"balatro-backend/game/hands"
+	"balatro-backend/game/planets"
+	"balatro-backend/game/rng"
+	"balatro-backend/game/run"
+	"balatro-backend/game/stakes"
+	"balatro-backend/game/tarots"
+	"balatro-backend/game/unlocks"
+	"balatro-backend/middleware"
+	"balatro-backend/models"
+	"balatro-backend/store"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// errRunConflict run başka bir istek tarafından güncellendiyse döner
+var errRunConflict = errors.New("run başka bir istek tarafından güncellendi, tekrar deneyin")
+
+// RunHandler sunucu tarafı run endpoint'leri
+type RunHandler struct {
+	Runs     store.RunStore
+	Profiles store.ProfileStore // Kilit kontrolü ve run sonu ilerlemesi için
+}
+
+// NewRunHandler verilen depolarla run handler'ı oluşturur
+func NewRunHandler(runs store.RunStore, profiles store.ProfileStore) *RunHandler {
+	return &RunHandler{Runs: runs, Profiles: profiles}
+}
+
+// CreateRun yeni bir run başlatır - POST /api/runs
+func (h *RunHandler) CreateRun(c *gin.Context) {
+	var request models.CreateRunRequest
+
+	// JSON request'i parse et
+	if !bindJSON(c, &request) {
+		return
+	}
+
+	// Kilitli desteler ve stake'ler profil ilerlemesiyle açılır
+	deck, ok := decks.Get(request.Deck)
+	if !ok {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Message: "Run oluşturulamadı",
+			Error:   decks.ErrUnknownDeck.Error(),
+		})
+		return
+	}
+	stake, ok := stakes.Get(request.Stake)
+	if !ok {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Message: "Run oluşturulamadı",
+			Error:   stakes.ErrUnknownStake.Error(),
+		})
+		return
+	}
+	userID := middleware.UserID(c)
+	profileCtx, profileCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer profileCancel()
+	profile, err := loadProfile(profileCtx, h.Profiles, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Profil yüklenemedi",
+			Error:   err.Error(),
+		})
+		return
+	}
+	if !unlocks.IsUnlocked(profile, unlocks.CategoryDecks, deck.ID) {
+		c.JSON(http.StatusForbidden, models.APIResponse{
+			Success: false,
+			Message: "Deste kilitli",
+			Error:   "deste henüz açılmadı: " + deck.ID,
+		})
+		return
+	}
+	if !unlocks.IsUnlocked(profile, unlocks.CategoryStakes, stake.ID) {
+		c.JSON(http.StatusForbidden, models.APIResponse{
+			Success: false,
+			Message: "Stake kilitli",
+			Error:   "stake henüz açılmadı: " + stake.ID,
+		})
+		return
+	}
+
+	newRun, err := run.New(userID, request.Seed, deck.ID, stake.ID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Message: "Run oluşturulamadı",
+			Error:   err.Error(),
+		})
+		return
+	}
+	// Run boyunca dükkan, başlangıçtaki kilitlere göre üretilir
+	newRun.Locked = unlocks.Locked(profile)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := h.Runs.Create(ctx, newRun); err != nil {
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Run kaydedilemedi",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	// Başarılı yanıt
+	c.JSON(http.StatusCreated, models.APIResponse{
+		Success: true,
+		Message: "Run başarıyla oluşturuldu",
+		Data:    runResponse(newRun, nil),
+	})
+}
+
+// GetRun run durumunu döndürür - GET /api/runs/:id
+func (h *RunHandler) GetRun(c *gin.Context) {
+	current, ok := h.loadRun(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Run başarıyla yüklendi",
+		Data:    runResponse(current, nil),
+	})
+}
+
+// PlayHand seçilen kartları oynar - POST /api/runs/:id/play
+func (h *RunHandler) PlayHand(c *gin.Context) {
+	var request models.CardSelectionRequest
+	if !bindJSON(c, &request) {
+		return
+	}
+
+	current, ok := h.loadRun(c)
+	if !ok {
+		return
+	}
+
+	outcome, err := run.Play(current, request.Cards)
+	if err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	// Run bittiyse (kazanıldı veya kaybedildi) profile bir kez işlenir; kazanılıp
+	// devam edilen bir run sonradan GAME_OVER olduğunda tekrar sayılmaz
+	record := (outcome.GameOver || outcome.Won) && !current.Recorded
+	if record {
+		current.Recorded = true
+	}
+
+	if err := h.saveRun(current); err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	// Kilit açma kurallarını değerlendir
+	extra := map[string]interface{}{"outcome": outcome}
+	if record {
+		extra["unlocked"] = recordRunEnd(h.Profiles, current, outcome.Won)
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "El başarıyla oynandı",
+		Data:    runResponse(current, extra),
+	})
+}
+
+// DiscardCards seçilen kartları atar - POST /api/runs/:id/discard
+func (h *RunHandler) DiscardCards(c *gin.Context) {
+	var request models.CardSelectionRequest
+	if !bindJSON(c, &request) {
+		return
+	}
+
+	current, ok := h.loadRun(c)
+	if !ok {
+		return
+	}
+
+	discarded, err := run.Discard(current, request.Cards)
+	if err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	if err := h.saveRun(current); err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Kartlar başarıyla atıldı",
+		Data:    runResponse(current, map[string]interface{}{"discarded": discarded}),
+	})
+}
+
+// BuyShopItem dükkandan öğe satın alır - POST /api/runs/:id/shop/buy
+func (h *RunHandler) BuyShopItem(c *gin.Context) {
+	var request models.ShopBuyRequest
+	if !bindJSON(c, &request) {
+		return
+	}
+
+	current, ok := h.loadRun(c)
+	if !ok {
+		return
+	}
+
+	item, err := run.Buy(current, request.Slot)
+	if err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	if err := h.saveRun(current); err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Öğe başarıyla satın alındı",
+		Data:    runResponse(current, map[string]interface{}{"item": item}),
+	})
+}
+
+// RerollShop dükkanı ücret karşılığında yeniler - POST /api/runs/:id/shop/reroll
+func (h *RunHandler) RerollShop(c *gin.Context) {
+	current, ok := h.loadRun(c)
+	if !ok {
+		return
+	}
+
+	if err := run.Reroll(current); err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	if err := h.saveRun(current); err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Dükkan yenilendi",
+		Data:    runResponse(current, nil),
+	})
+}
+
+// OpenPack satın alınmış paketi açar ve teklifleri döndürür - POST /api/runs/:id/packs/open
+func (h *RunHandler) OpenPack(c *gin.Context) {
+	var request models.PackOpenRequest
+	if !bindJSON(c, &request) {
+		return
+	}
+
+	current, ok := h.loadRun(c)
+	if !ok {
+		return
+	}
+
+	pack, err := run.OpenPack(current, request.Index)
+	if err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	if err := h.saveRun(current); err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Paket açıldı",
+		Data:    runResponse(current, map[string]interface{}{"pack": pack}),
+	})
+}
+
+// PickFromPack açık paketten seçilen teklifleri alır - POST /api/runs/:id/packs/pick
+func (h *RunHandler) PickFromPack(c *gin.Context) {
+	var request models.PackPickRequest
+	if !bindJSON(c, &request) {
+		return
+	}
+
+	current, ok := h.loadRun(c)
+	if !ok {
+		return
+	}
+
+	picked, err := run.PickFromPack(current, request.Choices)
+	if err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	if err := h.saveRun(current); err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Paket seçimi tamamlandı",
+		Data:    runResponse(current, map[string]interface{}{"picked": picked}),
+	})
+}
+
+// UseRunTarot run envanterindeki tarot kartını kullanır - POST /api/runs/:id/tarot/use
+func (h *RunHandler) UseRunTarot(c *gin.Context) {
+	var request models.UseTarotRequest
+	if !bindJSON(c, &request) {
+		return
+	}
+
+	current, ok := h.loadRun(c)
+	if !ok {
+		return
+	}
+
+	if err := run.UseTarot(current, request.TarotID, request.Targets); err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	if err := h.saveRun(current); err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Tarot kartı başarıyla kullanıldı",
+		Data:    runResponse(current, nil),
+	})
+}
+
+// AdvanceRun run'ı sonraki faza geçirir - POST /api/runs/:id/advance
+func (h *RunHandler) AdvanceRun(c *gin.Context) {
+	current, ok := h.loadRun(c)
+	if !ok {
+		return
+	}
+
+	if err := run.Advance(current); err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	if err := h.saveRun(current); err != nil {
+		respondRunError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Run sonraki faza geçti",
+		Data:    runResponse(current, nil),
+	})
+}
+
+// loadRun path'teki run'ı yükler; run yoksa veya oturumdaki kullanıcıya ait
+// değilse hata yanıtını yazar
+func (h *RunHandler) loadRun(c *gin.Context) (*models.Run, bool) {
+	return findRun(c, h.Runs, c.Param("id"))
+}
+
+// findRun verilen ID'deki run'ı yükler; run yoksa veya oturumdaki kullanıcıya
+// ait değilse hata yanıtını yazar
+func findRun(c *gin.Context, runs store.RunStore, id string) (*models.Run, bool) {
+	runID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Message: "Geçersiz run ID",
+			Error:   err.Error(),
+		})
+		return nil, false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	current, err := runs.Get(ctx, runID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			c.JSON(http.StatusNotFound, models.APIResponse{
+				Success: false,
+				Message: "Run bulunamadı",
+			})
+			return nil, false
+		}
+
+		c.JSON(http.StatusInternalServerError, models.APIResponse{
+			Success: false,
+			Message: "Run yüklenemedi",
+			Error:   err.Error(),
+		})
+		return nil, false
+	}
+
+	// Başka kullanıcıların run'ları görüntülenemez ve oynanamaz
+	if current.UserID != middleware.UserID(c) {
+		c.JSON(http.StatusForbidden, models.APIResponse{
+			Success: false,
+			Message: "Bu run size ait değil",
+		})
+		return nil, false
+	}
+
+	return current, true
+}
+
+// saveRun run'ı sürüm kontrolüyle kaydeder; arada başka bir güncelleme
+// olduysa errRunConflict döner
+func (h *RunHandler) saveRun(current *models.Run) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	expected := current.Version
+	current.Version++
+	current.UpdatedAt = time.Now()
+	current.State.LastPlayedTimestamp = current.UpdatedAt
+
+	err := h.Runs.Update(ctx, current, expected)
+	if errors.Is(err, store.ErrVersionConflict) {
+		return errRunConflict
+	}
+	return err
+}
+
+// respondRunError run hatalarını uygun HTTP durum koduyla yanıtlar
+func respondRunError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	message := "Run işlemi başarısız"
+
+	switch {
+	case errors.Is(err, run.ErrWrongPhase),
+		errors.Is(err, run.ErrNoHandsLeft),
+		errors.Is(err, run.ErrNoDiscardsLeft),
+		errors.Is(err, run.ErrPackOpen),
+		errors.Is(err, run.ErrNoPackOpen),
+		errors.Is(err, tarots.ErrNoEffect),
+		errors.Is(err, tarots.ErrJokersRequired),
+		errors.Is(err, errRunConflict):
+		status = http.StatusConflict
+		message = "İşlem şu anda yapılamaz"
+	case errors.Is(err, run.ErrInvalidSelection),
+		errors.Is(err, run.ErrNotEnoughMoney),
+		errors.Is(err, run.ErrJokerSlotsFull),
+		errors.Is(err, run.ErrItemNotAvailable),
+		errors.Is(err, hands.ErrNoCards),
+		errors.Is(err, planets.ErrUnknownPlanet),
+		errors.Is(err, tarots.ErrUnknownTarot),
+		errors.Is(err, tarots.ErrNotInInventory),
+		errors.Is(err, tarots.ErrInvalidTargets),
+		errors.Is(err, rng.ErrInvalidSeed):
+		status = http.StatusBadRequest
+		message = "Geçersiz işlem"
+	}
+
+	c.JSON(status, models.APIResponse{
+		Success: false,
+		Message: message,
+		Error:   err.Error(),
+	})
+}
+
+// runResponse run'ı istemcinin ihtiyaç duyduğu türetilmiş değerlerle birlikte döndürür
+func runResponse(current *models.Run, extra map[string]interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"run":   runView(current),
+		"blind": run.CurrentBlind(current),
+	}
+	for key, value := range extra {
+		data[key] = value
+	}
+	return data
+}
+
+// runView run'ı istemciye gösterilecek hale getirir; sunucunun ürettiği seed
+// run bitene kadar gizlenir
+func runView(current *models.Run) models.RunView {
+	state := current.State
+	view := models.RunView{
+		ID:                  current.ID,
+		Phase:               current.Phase,
+		DeckID:              current.DeckID,
+		StakeID:             current.StakeID,
+		Round:               current.Round,
+		RoundScore:          current.RoundScore,
+		HandsPlayed:         current.HandsPlayed,
+		CurrentAnte:         state.CurrentAnte,
+		BlindKind:           state.BlindKind,
+		Money:               state.Money,
+		Lives:               state.Lives,
+		HandsLeft:           state.HandsLeft,
+		DiscardsLeft:        state.DiscardsLeft,
+		HandCards:           state.HandCards,
+		DeckRemaining:       len(state.DeckCards),
+		DeckSize:            len(current.Deck),
+		Jokers:              state.Jokers,
+		TarotCardsInventory: state.TarotCardsInventory,
+		PlanetLevels:        state.PlanetLevels,
+		VouchersOwned:       state.VouchersOwned,
+		Shop:                current.Shop,
+		RerollCost:          current.RerollCost,
+		Packs:               current.Packs,
+		OpenPack:            current.OpenPack,
+		HandCounts:          current.HandCounts,
+		CashOut:             current.CashOut,
+		Locked:              current.Locked,
+		Deck:                current.Deck,
+		Version:             current.Version,
+		CreatedAt:           current.CreatedAt,
+		UpdatedAt:           current.UpdatedAt,
+	}
+	if !current.SeedGenerated || current.Phase == models.PhaseGameOver {
+		view.Seed = current.Seed
+	}
+	return view
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+
+	"balatro-backend/game/cards"
+	"balatro-backend/game/scoring"
+	"balatro-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CalculateScore oynanan elin puanını adım adım hesaplar - POST /api/score/calculate
+func CalculateScore(c *gin.Context) {
+	var request models.CalculateScoreRequest
+
+	// JSON request'i parse et
+	if !bindJSON(c, &request) {
+		return
+	}
+
+	// Kartları doğrula (enhancement, edition ve seal dahil)
+	for _, list := range [][]models.Card{request.PlayedCards, request.HeldCards} {
+		if err := cards.ValidateAll(list); err != nil {
+			c.JSON(http.StatusBadRequest, models.APIResponse{
+				Success: false,
+				Message: "Geçersiz kart",
+				Error:   err.Error(),
+			})
+			return
+		}
+	}
+
+	// Puanı hesapla
+	result, err := scoring.Calculate(scoring.Input{
+		Played:       request.PlayedCards,
+		Held:         request.HeldCards,
+		Jokers:       request.Jokers,
+		PlanetLevels: request.PlanetLevels,
+		NoLivesLost:  true, // Şimdilik her zaman true (frontend ile aynı)
+	})
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Message: "Puan hesaplanamadı",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	// Başarılı yanıt
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Puan başarıyla hesaplandı",
+		Data:    result,
+	})
+}
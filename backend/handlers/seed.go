@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"balatro-backend/game/rng"
+	"balatro-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PreviewSeed seed'in ilk N kart çekimini döndürür - GET /api/seed/:seed/preview
+func PreviewSeed(c *gin.Context) {
+	seed, err := rng.NormalizeSeed(c.Param("seed"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.APIResponse{
+			Success: false,
+			Message: "Geçersiz seed",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	// Query parametreleri
+	count, err := strconv.Atoi(c.DefaultQuery("count", "8"))
+	if err != nil || count < 1 || count > 52 {
+		count = 8 // Varsayılan el boyutu, maksimum 52
+	}
+
+	round, err := strconv.Atoi(c.DefaultQuery("round", "1"))
+	if err != nil || round < 1 {
+		round = 1
+	}
+
+	// Desteyi karıştır ve ilk kartları çek
+	draws, _ := rng.Draw(rng.Deck(seed, round), count)
+
+	// Başarılı yanıt
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: "Seed önizlemesi başarıyla oluşturuldu",
+		Data: map[string]interface{}{
+			"seed":       seed,
+			"rngVersion": rng.Version,
+			"round":      round,
+			"count":      count,
+			"draws":      draws,
+		},
+	})
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"balatro-backend/auth"
+	"balatro-backend/middleware"
+	"balatro-backend/models"
+	"balatro-backend/store"
+	"balatro-backend/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	if err := validation.Register(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// testServer bellek depolarıyla kurulmuş oyun durumu, yüksek skor ve moderasyon route'ları
+type testServer struct {
+	router *gin.Engine
+	stores store.Stores
+	tokens *auth.Signer
+}
+
+// newTestServer route'ları main.go'daki gibi (rate limit olmadan) kurar
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	s := &testServer{
+		router: gin.New(),
+		stores: store.NewMemoryStores(),
+		tokens: auth.NewSigner([]byte("test-secret"), time.Hour),
+	}
+	gameStates := NewGameStateHandler(s.stores.PlayerStates)
+	highscores := NewHighscoreHandler(s.stores.Highscores, s.stores.Users, s.stores.Moderation, s.stores.Runs)
+	admin := NewAdminHandler(s.stores.Highscores, s.stores.Moderation)
+
+	api := s.router.Group("/api")
+	authorized := api.Group("")
+	authorized.Use(middleware.AuthMiddleware(s.tokens, s.stores.Users))
+	authorized.POST("/game-state", gameStates.SaveGameState)
+	authorized.GET("/game-state", gameStates.LoadGameState)
+	authorized.POST("/highscores", highscores.SaveHighscore)
+	api.GET("/highscores", highscores.GetHighscores)
+	api.GET("/highscores/user/:userId", highscores.GetUserHighscore)
+	api.POST("/admin/bans/:userId", admin.BanUser) // Yetki kontrolü AdminMiddleware'in işi
+	api.DELETE("/admin/bans/:userId", admin.UnbanUser)
+	api.GET("/admin/highscores/suspicious", admin.ListSuspiciousHighscores)
+	return s
+}
+
+// login kullanıcıyı oluşturur ve ID'sini ve token'ını döndürür
+func (s *testServer) login(t *testing.T, username string) (string, string) {
+	t.Helper()
+	user := &models.User{Username: username, DisplayName: username}
+	if err := s.stores.Users.Create(context.Background(), user); err != nil {
+		t.Fatalf("Create user: %v", err)
+	}
+	token, _, err := s.tokens.Issue(user.ID.Hex())
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	return user.ID.Hex(), token
+}
+
+// do isteği gönderir ve durum kodunu ve çözülmüş yanıtı döndürür
+func (s *testServer) do(t *testing.T, method, path, token string, body interface{}) (int, models.APIResponse) {
+	t.Helper()
+	var payload bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&payload).Encode(body); err != nil {
+			t.Fatalf("encode: %v", err)
+		}
+	}
+	req := httptest.NewRequest(method, path, &payload)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	var response models.APIResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("%s %s: decode %q: %v", method, path, rec.Body.String(), err)
+	}
+	return rec.Code, response
+}
+
+// hasFieldError yanıtta verilen alan ve kodla hata olup olmadığını kontrol eder
+func hasFieldError(response models.APIResponse, field, code string) bool {
+	for _, e := range response.Errors {
+		if e.Field == field && e.Code == code {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"balatro-backend/models"
+	"balatro-backend/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bindJSON request gövdesini parse edip etiketlerle doğrular. Hata varsa
+// alan bazında hatalarla 400 döner ve false döndürür.
+func bindJSON(c *gin.Context, request interface{}) bool {
+	if err := c.ShouldBindJSON(request); err != nil {
+		respondInvalid(c, "Geçersiz request formatı", validation.Errors(err))
+		return false
+	}
+	return true
+}
+
+// respondInvalid doğrulama hatalarını models.APIResponse.Errors içinde döner
+func respondInvalid(c *gin.Context, message string, errs []models.FieldError) {
+	c.JSON(http.StatusBadRequest, models.APIResponse{
+		Success: false,
+		Message: message,
+		Errors:  errs,
+	})
+}
@@ -1,14 +1,24 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
+	"balatro-backend/auth"
 	"balatro-backend/config"
 	"balatro-backend/handlers"
 	"balatro-backend/middleware"
+	"balatro-backend/migrations"
+	"balatro-backend/models"
+	"balatro-backend/store"
+	"balatro-backend/validation"
 
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
@@ -27,23 +37,42 @@ func main() {
 	}
 	gin.SetMode(ginMode)
 
-	// MongoDB bağlantısını kur
-	config.ConnectDatabase()
-	defer config.DisconnectDatabase()
+	// "migrate" komutu yalnızca migration'ları çalıştırır ve çıkar
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrateCommand(os.Args[2:])
+		return
+	}
+
+	// "admin" komutu hesap rollerini yönetir ve çıkar
+	if len(os.Args) > 1 && os.Args[1] == "admin" {
+		runAdminCommand(os.Args[2:])
+		return
+	}
 
-	// Koleksiyonları ve indeksleri başlat
-	config.InitializeCollections()
+	// Depolamayı kur (varsayılan MongoDB)
+	stores := setupStores()
+	defer config.DisconnectDatabase()
 
 	// Gin router'ı oluştur
 	router := gin.New()
+	setupTrustedProxies(router)
 
 	// Middleware'leri ekle
 	router.Use(middleware.LoggerMiddleware())
 	router.Use(middleware.CORSMiddleware())
 	router.Use(gin.Recovery()) // Panic recovery
 
+	// Request doğrulama etiketlerini kaydet
+	if err := validation.Register(); err != nil {
+		log.Fatal("❌ Doğrulama kuralları kaydedilemedi:", err)
+	}
+
 	// API route'larını tanımla
-	setupRoutes(router)
+	adminKey := os.Getenv("ADMIN_API_KEY")
+	if adminKey == "" {
+		log.Println("⚠️ ADMIN_API_KEY tanımlı değil, admin API yalnızca admin rolündeki hesaplarla kullanılabilir")
+	}
+	setupRoutes(router, stores, setupTokenSigner(), middleware.NewMemoryRateLimitStore(), adminKey)
 
 	// Port ayarla
 	port := os.Getenv("PORT")
@@ -54,10 +83,26 @@ func main() {
 	// Sunucuyu başlat
 	log.Printf("🚀 Balatro Backend API başlatılıyor - Port: %s", port)
 	log.Printf("📋 API Endpoints:")
-	log.Printf("   - POST /api/game-state (Oyun durumu kaydet)")
-	log.Printf("   - GET  /api/game-state/:userId (Oyun durumu yükle)")
-	log.Printf("   - POST /api/highscores (Yüksek skor kaydet)")
+	log.Printf("   - POST /api/auth/register (Hesap oluştur)")
+	log.Printf("   - POST /api/auth/login (Giriş yap)")
+	log.Printf("   - POST /api/auth/guest (Misafir oturumu)")
+	log.Printf("   - POST /api/auth/upgrade (Misafiri kayıtlı hesaba yükselt) 🔒")
+	log.Printf("   - POST /api/game-state (Oyun durumu kaydet) 🔒")
+	log.Printf("   - GET  /api/game-state (Oyun durumu yükle) 🔒")
+	log.Printf("   - POST /api/game-state/tarot/use (Tarot kartı kullan) 🔒")
+	log.Printf("   - POST /api/game-state/planet/use (Planet kartı kullan) 🔒")
+	log.Printf("   - POST /api/highscores (Yüksek skor kaydet) 🔒")
 	log.Printf("   - GET  /api/highscores (Yüksek skorları listele)")
+	log.Printf("   - GET  /api/profile/:userId/collection (Koleksiyon ve kilitler)")
+	log.Printf("   - POST /api/runs (Yeni run başlat) 🔒")
+	log.Printf("   - POST /api/runs/:id/{play,discard,shop/buy,shop/reroll,packs/open,packs/pick,tarot/use,advance} (Run aksiyonları) 🔒")
+	log.Printf("   - GET  /api/blinds (Ante blind'ları)")
+	log.Printf("   - POST /api/score/calculate (El puanı hesapla)")
+	log.Printf("   - GET  /api/seed/:seed/preview (Seed önizleme)")
+	log.Printf("   - GET  /api/admin/highscores/suspicious (Şüpheli skorlar) 🛡️")
+	log.Printf("   - POST /api/admin/highscores/:id/moderate (Skor işaretle/sil/geri al) 🛡️")
+	log.Printf("   - GET|POST|DELETE /api/admin/bans[/:userId] (Liderlik tablosu yasakları) 🛡️")
+	log.Printf("   - GET  /api/admin/audit-log (Admin işlem kaydı) 🛡️")
 	log.Printf("   - GET  /api/health (Sağlık durumu)")
 	log.Printf("   - GET  /api/info (API bilgileri)")
 
@@ -77,24 +122,185 @@ func main() {
 	}
 }
 
+// setupStores STORAGE_DRIVER environment variable'ına göre depoları oluşturur:
+// "memory" veritabanısız çalışır, "file" tüm veriyi STORAGE_PATH Bolt dosyasında
+// saklar, boş veya "mongo" MONGODB_URI ile MongoDB'ye bağlanır
+func setupStores() store.Stores {
+	switch driver := os.Getenv("STORAGE_DRIVER"); driver {
+	case "memory":
+		log.Println("⚠️ Bellek içi depolama kullanılıyor, veriler yeniden başlatmada kaybolur")
+		return store.NewMemoryStores()
+	case "file":
+		path := os.Getenv("STORAGE_PATH")
+		if path == "" {
+			path = "balatro-data.db" // Varsayılan veri dosyası
+		}
+		stores, err := store.NewFileStores(path)
+		if err != nil {
+			log.Fatal("❌ Dosya depolama başlatılamadı:", err)
+		}
+		log.Printf("✅ Dosya depolama kullanılıyor: %s", path)
+		return stores
+	case "", "mongo":
+		// MongoDB bağlantısını kur
+		config.ConnectDatabase()
+
+		// Bekleyen migration'ları uygula (indeksler ve şema yükseltmeleri)
+		applyMigrations()
+		stores := store.NewMongoStores(config.DB)
+
+		// Misafir yükseltme transaction kullanır; standalone sunucuda kapatılır
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		transactions, err := store.SupportsTransactions(ctx, config.DB)
+		if err != nil {
+			log.Fatal("❌ MongoDB transaction desteği kontrol edilemedi:", err)
+		}
+		if !transactions {
+			log.Println("⚠️ MongoDB transaction desteklemiyor (replica set veya mongos değil); misafir hesabı yükseltme kapalı")
+			stores.Accounts = store.UnsupportedAccounts{}
+		}
+		return stores
+	default:
+		log.Fatalf("❌ Bilinmeyen STORAGE_DRIVER: %s (mongo, file veya memory olmalı)", driver)
+		return store.Stores{}
+	}
+}
+
+// setupTrustedProxies istemci IP'sinin X-Forwarded-For gibi başlıklardan okunacağı
+// proxy'leri TRUSTED_PROXIES'ten (virgülle ayrılmış IP/CIDR) ayarlar. Tanımlı
+// değilse hiçbir proxy'ye güvenilmez; aksi halde istemciler başlığı değiştirerek
+// IP başına rate limit'i atlatabilir.
+func setupTrustedProxies(router *gin.Engine) {
+	var proxies []string
+	for _, proxy := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+	if err := router.SetTrustedProxies(proxies); err != nil {
+		log.Fatal("❌ Geçersiz TRUSTED_PROXIES:", err)
+	}
+	if len(proxies) > 0 {
+		log.Printf("✅ Güvenilen proxy'ler: %s", strings.Join(proxies, ", "))
+	}
+}
+
+// setupTokenSigner AUTH_SECRET ile oturum token'ı imzalayıcısını oluşturur.
+// AUTH_SECRET yoksa rastgele bir anahtar üretilir; bu durumda token'lar
+// yeniden başlatmada geçersiz olur. AUTH_TOKEN_TTL (ör. "24h") opsiyoneldir.
+func setupTokenSigner() *auth.Signer {
+	secret := []byte(os.Getenv("AUTH_SECRET"))
+	if len(secret) == 0 {
+		log.Println("⚠️ AUTH_SECRET tanımlı değil, geçici bir anahtar üretildi (oturumlar yeniden başlatmada düşer)")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			log.Fatal("❌ Oturum anahtarı üretilemedi:", err)
+		}
+	}
+
+	ttl := auth.DefaultTokenTTL
+	if value := os.Getenv("AUTH_TOKEN_TTL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("❌ Geçersiz AUTH_TOKEN_TTL: %s", value)
+		}
+		ttl = parsed
+	}
+	return auth.NewSigner(secret, ttl)
+}
+
+// Rate limit grupları: yazmalar okumalardan, hesap işlemleri (şifre denemeleri)
+// ve skor gönderimleri diğer yazmalardan daha sıkı sınırlanır
+var (
+	readRateLimit      = middleware.RateLimit{Burst: 120, Refill: 250 * time.Millisecond} // ~240 istek/dk
+	writeRateLimit     = middleware.RateLimit{Burst: 30, Refill: 500 * time.Millisecond}  // ~120 istek/dk
+	authRateLimit      = middleware.RateLimit{Burst: 10, Refill: 6 * time.Second}         // ~10 istek/dk
+	highscoreRateLimit = middleware.RateLimit{Burst: 5, Refill: 30 * time.Second}         // ~2 istek/dk
+)
+
 // setupRoutes API route'larını tanımlar
-func setupRoutes(router *gin.Engine) {
+// adminKey boşsa admin API'ye yalnızca admin rolündeki hesaplarla erişilebilir
+func setupRoutes(router *gin.Engine, stores store.Stores, tokens *auth.Signer, limiter middleware.RateLimitStore, adminKey string) {
+	users := handlers.NewAuthHandler(stores.Users, stores.Accounts, tokens)
+	gameStates := handlers.NewGameStateHandler(stores.PlayerStates)
+	highscores := handlers.NewHighscoreHandler(stores.Highscores, stores.Users, stores.Moderation, stores.Runs)
+	profiles := handlers.NewProfileHandler(stores.Profiles)
+	runs := handlers.NewRunHandler(stores.Runs, stores.Profiles)
+	admin := handlers.NewAdminHandler(stores.Highscores, stores.Moderation)
+
+	// Rate limit middleware'leri (oturum gerektiren route'larda kullanıcı başına da sınırlar)
+	reads := middleware.RateLimitMiddleware(limiter, "read", readRateLimit)
+	writes := middleware.RateLimitMiddleware(limiter, "write", writeRateLimit)
+	logins := middleware.RateLimitMiddleware(limiter, "auth", authRateLimit)
+	scores := middleware.RateLimitMiddleware(limiter, "highscore", highscoreRateLimit)
+
 	// API v1 grubu
 	api := router.Group("/api")
 
 	// Sistem endpoint'leri
-	api.GET("/health", handlers.HealthCheck)
-	api.GET("/info", handlers.GetAPIInfo)
+	api.GET("/health", handlers.NewHealthHandler(stores.Health).HealthCheck)
+	api.GET("/info", reads, handlers.GetAPIInfo)
+
+	// Hesap endpoint'leri
+	api.POST("/auth/register", logins, users.Register)
+	api.POST("/auth/login", logins, users.Login)
+	api.POST("/auth/guest", logins, users.Guest)
+
+	// Oturum gerektiren endpoint'ler (kullanıcı token'dan belirlenir)
+	authorized := api.Group("")
+	authorized.Use(middleware.AuthMiddleware(tokens, stores.Users))
+
+	// Misafir hesabını kayıtlı hesaba yükseltme
+	authorized.POST("/auth/upgrade", logins, users.UpgradeGuest)
 
 	// Oyun durumu endpoint'leri
-	api.POST("/game-state", handlers.SaveGameState)
-	api.GET("/game-state/:userId", handlers.LoadGameState)
-	api.DELETE("/game-state/:userId", handlers.DeleteGameState)
+	authorized.POST("/game-state", writes, gameStates.SaveGameState)
+	authorized.GET("/game-state", reads, gameStates.LoadGameState)
+	authorized.DELETE("/game-state", writes, gameStates.DeleteGameState)
+	authorized.POST("/game-state/tarot/use", writes, gameStates.UseTarot)
+	authorized.POST("/game-state/planet/use", writes, gameStates.UsePlanet)
 
 	// Yüksek skor endpoint'leri
-	api.POST("/highscores", handlers.SaveHighscore)
-	api.GET("/highscores", handlers.GetHighscores)
-	api.GET("/highscores/user/:userId", handlers.GetUserHighscore)
+	authorized.POST("/highscores", scores, highscores.SaveHighscore)
+	api.GET("/highscores", reads, highscores.GetHighscores)
+	api.GET("/highscores/user/:userId", reads, highscores.GetUserHighscore)
+
+	// Profil endpoint'leri
+	api.GET("/profile/:userId/collection", reads, profiles.GetCollection)
+
+	// Run endpoint'leri (sunucu tarafı oyun akışı)
+	authorized.POST("/runs", writes, runs.CreateRun)
+	authorized.GET("/runs/:id", reads, runs.GetRun)
+	authorized.POST("/runs/:id/play", writes, runs.PlayHand)
+	authorized.POST("/runs/:id/discard", writes, runs.DiscardCards)
+	authorized.POST("/runs/:id/shop/buy", writes, runs.BuyShopItem)
+	authorized.POST("/runs/:id/shop/reroll", writes, runs.RerollShop)
+	authorized.POST("/runs/:id/packs/open", writes, runs.OpenPack)
+	authorized.POST("/runs/:id/packs/pick", writes, runs.PickFromPack)
+	authorized.POST("/runs/:id/tarot/use", writes, runs.UseRunTarot)
+	authorized.POST("/runs/:id/advance", writes, runs.AdvanceRun)
+
+	// Admin endpoint'leri (admin rolü veya X-Admin-Key gerektirir). Anahtar
+	// denemeleri giriş denemeleri gibi, yetki kontrolünden önce sınırlanır.
+	admins := api.Group("/admin")
+	admins.Use(logins, middleware.AdminMiddleware(tokens, stores.Users, adminKey))
+	admins.GET("/highscores/suspicious", reads, admin.ListSuspiciousHighscores)
+	admins.POST("/highscores/:id/moderate", writes, admin.ModerateHighscore)
+	admins.GET("/bans", reads, admin.ListBans)
+	admins.POST("/bans/:userId", writes, admin.BanUser)
+	admins.DELETE("/bans/:userId", writes, admin.UnbanUser)
+	admins.GET("/audit-log", reads, admin.ListAuditLog)
+
+	// Blind endpoint'leri (durum değiştirmediği için okuma limiti)
+	api.GET("/blinds", reads, handlers.GetBlinds)
+	api.POST("/blinds/check", reads, handlers.CheckBlind)
+
+	// Puan hesaplama endpoint'leri (durum değiştirmediği için okuma limiti)
+	api.POST("/score/calculate", reads, handlers.CalculateScore)
+
+	// Seed endpoint'leri
+	api.GET("/seed/:seed/preview", reads, handlers.PreviewSeed)
 
 	// Root endpoint
 	router.GET("/", func(c *gin.Context) {
@@ -114,4 +320,66 @@ func setupRoutes(router *gin.Engine) {
 			"message": "Geçerli endpoint'ler için /api/info adresini ziyaret edin",
 		})
 	})
-}
\ No newline at end of file
+}
+
+// applyMigrations bekleyen migration'ları çalıştırır; hata durumunda sunucu başlamaz
+func applyMigrations() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	count, err := migrations.Run(ctx, config.DB)
+	if err != nil {
+		log.Fatal("❌ Migration hatası:", err)
+	}
+	log.Printf("✅ Migration'lar güncel (%d yeni uygulandı)", count)
+}
+
+// runMigrateCommand "migrate [status]" komutunu çalıştırır
+func runMigrateCommand(args []string) {
+	config.ConnectDatabase()
+	defer config.DisconnectDatabase()
+
+	if len(args) > 0 && args[0] == "status" {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		statuses, err := migrations.Statuses(ctx, config.DB)
+		if err != nil {
+			log.Fatal("❌ Migration durumu okunamadı:", err)
+		}
+		for _, status := range statuses {
+			applied := "bekliyor"
+			if status.AppliedAt != nil {
+				applied = status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%3d  %-40s %s\n", status.Version, status.Name, applied)
+		}
+		return
+	}
+
+	applyMigrations()
+}
+
+// runAdminCommand "admin grant <kullanıcı>" ve "admin revoke <kullanıcı>"
+// komutlarıyla hesabın admin rolünü verir veya geri alır
+func runAdminCommand(args []string) {
+	if len(args) != 2 || (args[0] != "grant" && args[0] != "revoke") {
+		log.Fatal("❌ Kullanım: admin grant|revoke <kullanıcı adı>")
+	}
+
+	stores := setupStores()
+	defer config.DisconnectDatabase()
+
+	role := models.RoleAdmin
+	if args[0] == "revoke" {
+		role = ""
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := stores.Users.SetRole(ctx, strings.ToLower(args[1]), role); err != nil {
+		log.Fatalf("❌ Rol güncellenemedi (%s): %v", args[1], err)
+	}
+	log.Printf("✅ %s için admin rolü güncellendi: %q", args[1], role)
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"balatro-backend/auth"
+	"balatro-backend/models"
+	"balatro-backend/store"
+
+	"github.com/gin-gonic/gin"
+)
+
+// adminKey işlemi yapan admin'in gin context'indeki anahtarı
+const adminKey = "admin"
+
+// AdminAPIKeyHeader API anahtarıyla yönetim erişimi için başlık
+const AdminAPIKeyHeader = "X-Admin-Key"
+
+// AdminMiddleware yönetim route'larını korur. İstek ya X-Admin-Key başlığında
+// apiKey ile eşleşen anahtarı ya da admin rolündeki bir kullanıcının Bearer
+// token'ını taşımalıdır. apiKey boşsa anahtarla erişim kapalıdır.
+func AdminMiddleware(signer *auth.Signer, users store.UserStore, apiKey string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// API anahtarı (otomasyon ve ilk kurulum için)
+		if key := c.GetHeader(AdminAPIKeyHeader); key != "" {
+			if apiKey == "" || subtle.ConstantTimeCompare([]byte(key), []byte(apiKey)) != 1 {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, models.APIResponse{
+					Success: false,
+					Message: "Geçersiz admin anahtarı",
+				})
+				return
+			}
+			c.Set(adminKey, "api-key")
+			c.Next()
+			return
+		}
+
+		// Admin rolündeki kullanıcı
+		token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, models.APIResponse{
+				Success: false,
+				Message: "Yönetim erişimi için oturum veya admin anahtarı gerekli",
+			})
+			return
+		}
+		claims, err := signer.Parse(strings.TrimSpace(token))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, models.APIResponse{
+				Success: false,
+				Message: "Geçersiz oturum",
+				Error:   err.Error(),
+			})
+			return
+		}
+
+		// Rol token'da taşınmaz; her istekte güncel değer okunur
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		user, err := users.GetByID(ctx, claims.Subject)
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, models.APIResponse{
+				Success: false,
+				Message: "Kullanıcı yüklenemedi",
+				Error:   err.Error(),
+			})
+			return
+		}
+		if user == nil || user.Role != models.RoleAdmin {
+			c.AbortWithStatusJSON(http.StatusForbidden, models.APIResponse{
+				Success: false,
+				Message: "Bu işlem için admin yetkisi gerekli",
+			})
+			return
+		}
+
+		c.Set(userIDKey, claims.Subject)
+		c.Set(adminKey, "user:"+claims.Subject)
+		c.Next()
+	}
+}
+
+// AdminID AdminMiddleware'in doğruladığı admin'i ("user:<id>" veya "api-key") döndürür
+func AdminID(c *gin.Context) string {
+	return c.GetString(adminKey)
+}
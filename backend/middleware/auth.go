@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"balatro-backend/auth"
+	"balatro-backend/models"
+	"balatro-backend/store"
+
+	"github.com/gin-gonic/gin"
+)
+
+// userIDKey doğrulanmış kullanıcı ID'sinin gin context'indeki anahtarı
+const userIDKey = "userID"
+
+// AuthMiddleware "Authorization: Bearer <token>" başlığını doğrular ve
+// kullanıcı ID'sini context'e koyar. Token yoksa, geçersizse veya hesap artık
+// yoksa (ör. kayıtlı hesaba yükseltilmiş misafir) 401 döner.
+func AuthMiddleware(signer *auth.Signer, users store.UserStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		token, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok || strings.TrimSpace(token) == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, models.APIResponse{
+				Success: false,
+				Message: "Oturum açmanız gerekiyor",
+				Error:   "Authorization başlığı eksik",
+			})
+			return
+		}
+
+		claims, err := signer.Parse(strings.TrimSpace(token))
+		if err != nil {
+			message := "Geçersiz oturum"
+			if errors.Is(err, auth.ErrTokenExpired) {
+				message = "Oturumun süresi doldu, tekrar giriş yapın"
+			}
+			c.AbortWithStatusJSON(http.StatusUnauthorized, models.APIResponse{
+				Success: false,
+				Message: message,
+				Error:   err.Error(),
+			})
+			return
+		}
+
+		// Silinen hesapların token'ları süreleri dolmadan da geçersizdir
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if _, err := users.GetByID(ctx, claims.Subject); err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, models.APIResponse{
+					Success: false,
+					Message: "Hesap bulunamadı, tekrar giriş yapın",
+				})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusInternalServerError, models.APIResponse{
+				Success: false,
+				Message: "Kullanıcı yüklenemedi",
+				Error:   err.Error(),
+			})
+			return
+		}
+
+		c.Set(userIDKey, claims.Subject)
+		c.Next()
+	}
+}
+
+// UserID AuthMiddleware'in doğruladığı kullanıcı ID'sini döndürür
+func UserID(c *gin.Context) string {
+	return c.GetString(userIDKey)
+}
@@ -32,7 +32,7 @@ func CORSMiddleware() gin.HandlerFunc {
 		// CORS başlıkları
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
 		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization")
-		c.Header("Access-Control-Expose-Headers", "Content-Length")
+		c.Header("Access-Control-Expose-Headers", "Content-Length, Retry-After")
 		c.Header("Access-Control-Allow-Credentials", "true")
 
 		// Preflight request'ler için
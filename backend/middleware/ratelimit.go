@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"balatro-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimit token bucket ayarı: bucket en fazla Burst istek biriktirir ve
+// her Refill süresinde bir istek hakkı yenilenir
+type RateLimit struct {
+	Burst  int
+	Refill time.Duration
+}
+
+// RateLimitStore bucket durumlarını saklar. Varsayılan MemoryRateLimitStore
+// tek sunucu içindir; birden fazla sunucu aynı limitleri paylaşacaksa ortak
+// bir depo (ör. Redis) bu arayüzle eklenebilir.
+type RateLimitStore interface {
+	// Take key'in bucket'ından bir istek hakkı almaya çalışır; izin verilmezse
+	// bir sonraki hakka kadar beklenmesi gereken süreyi döndürür
+	Take(ctx context.Context, key string, limit RateLimit) (allowed bool, retryAfter time.Duration, err error)
+}
+
+// RateLimitMiddleware istekleri istemci IP'si ve (AuthMiddleware'den sonra
+// kullanılırsa) oturumdaki kullanıcı başına sınırlar. Her grup kendi
+// bucket'larını kullanır. Limit aşılırsa 429 ve Retry-After döner; depo
+// hatalarında istek engellenmez.
+func RateLimitMiddleware(store RateLimitStore, group string, limit RateLimit) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		keys := []string{group + ":ip:" + c.ClientIP()}
+		if userID := UserID(c); userID != "" {
+			keys = append(keys, group+":user:"+userID)
+		}
+
+		for _, key := range keys {
+			allowed, retryAfter, err := store.Take(c.Request.Context(), key, limit)
+			if err != nil {
+				log.Printf("⚠️ Rate limit deposu hatası (%s): %v", key, err)
+				continue
+			}
+			if allowed {
+				continue
+			}
+
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			if seconds < 1 {
+				seconds = 1
+			}
+			c.Header("Retry-After", strconv.Itoa(seconds))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, models.APIResponse{
+				Success: false,
+				Message: "Çok fazla istek, lütfen biraz bekleyin",
+				Data: map[string]interface{}{
+					"retryAfter": seconds,
+				},
+				Error: "rate limit aşıldı: " + group,
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// rateLimitSweepInterval dolu bucket'ların bellekten temizlenme aralığı
+const rateLimitSweepInterval = time.Minute
+
+// bucket tek bir anahtarın token bucket durumu
+type bucket struct {
+	tokens float64
+	last   time.Time
+	limit  RateLimit
+}
+
+// MemoryRateLimitStore süreç belleğinde çalışan RateLimitStore
+type MemoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryRateLimitStore boş bir bellek içi rate limit deposu oluşturur
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{buckets: map[string]*bucket{}, now: time.Now}
+}
+
+// Take key'in bucket'ını geçen süre kadar doldurur ve bir hak harcar
+func (s *MemoryRateLimitStore) Take(ctx context.Context, key string, limit RateLimit) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now, limit: limit}
+		s.buckets[key] = b
+	}
+
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+float64(now.Sub(b.last))/float64(limit.Refill))
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0, nil
+	}
+	return false, time.Duration((1 - b.tokens) * float64(limit.Refill)), nil
+}
+
+// sweep tamamen dolmuş (uzun süredir kullanılmayan) bucket'ları siler;
+// yeniden oluşturulduklarında zaten dolu başlarlar
+func (s *MemoryRateLimitStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if now.Sub(b.last) >= time.Duration(b.limit.Burst)*b.limit.Refill {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"balatro-backend/game/decks"
+	"balatro-backend/game/stakes"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collection uygulanan migration'ların kaydedildiği koleksiyon
+const Collection = "schema_migrations"
+
+// Migration tek bir veritabanı şema değişikliği. Up birden fazla kez
+// çalıştırılabilecek şekilde (idempotent) yazılmalıdır.
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(ctx context.Context, db *mongo.Database) error
+}
+
+// Record uygulanmış bir migration kaydı
+type Record struct {
+	Version   int       `json:"version" bson:"version"`
+	Name      string    `json:"name" bson:"name"`
+	AppliedAt time.Time `json:"appliedAt" bson:"appliedAt"`
+}
+
+// Status bir migration'ın uygulanma durumu
+type Status struct {
+	Version   int        `json:"version"`
+	Name      string     `json:"name"`
+	AppliedAt *time.Time `json:"appliedAt,omitempty"` // Uygulanmadıysa nil
+}
+
+// all tüm migration'lar sürüm sırasıyla. Yeni migration'lar listenin sonuna
+// bir sonraki sürümle eklenir; uygulanmış olanlar değiştirilmemelidir.
+var all = []Migration{
+	{Version: 1, Name: "create_indexes", Up: createIndexes},
+	{Version: 2, Name: "player_states_schema_version", Up: upgradePlayerStates},
+	{Version: 3, Name: "highscores_default_deck_and_stake", Up: defaultHighscoreDeckAndStake},
+	{Version: 4, Name: "users_username_index", Up: createUserIndexes},
+	{Version: 5, Name: "users_guest_indexes", Up: createGuestIndexes},
+	{Version: 6, Name: "moderation_indexes", Up: createModerationIndexes},
+	{Version: 7, Name: "highscores_banned_flag", Up: flagBannedHighscores},
+	{Version: 8, Name: "highscores_run_index", Up: createHighscoreRunIndex},
+}
+
+// All tüm migration'ları sürüm sırasıyla döndürür
+func All() []Migration {
+	return append([]Migration{}, all...)
+}
+
+// applied uygulanmış migration kayıtlarını sürüme göre döndürür
+func applied(ctx context.Context, db *mongo.Database) (map[int]Record, error) {
+	cursor, err := db.Collection(Collection).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var records []Record
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+	result := make(map[int]Record, len(records))
+	for _, record := range records {
+		result[record.Version] = record
+	}
+	return result, nil
+}
+
+// Statuses tüm migration'ların uygulanma durumunu döndürür
+func Statuses(ctx context.Context, db *mongo.Database) ([]Status, error) {
+	done, err := applied(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]Status, 0, len(all))
+	for _, migration := range all {
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if record, ok := done[migration.Version]; ok {
+			appliedAt := record.AppliedAt
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Run uygulanmamış migration'ları sırasıyla çalıştırır ve kaydeder. İlk
+// hatada durur; hatalı migration kaydedilmez ve sonraki çalıştırmada
+// yeniden denenir. Uygulanan migration sayısını döndürür.
+func Run(ctx context.Context, db *mongo.Database) (int, error) {
+	records := db.Collection(Collection)
+	_, err := records.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "version", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("migration koleksiyonu hazırlanamadı: %w", err)
+	}
+
+	done, err := applied(ctx, db)
+	if err != nil {
+		return 0, fmt.Errorf("uygulanan migration'lar okunamadı: %w", err)
+	}
+
+	count := 0
+	for _, migration := range all {
+		if _, ok := done[migration.Version]; ok {
+			continue
+		}
+		log.Printf("🔄 Migration %d (%s) uygulanıyor", migration.Version, migration.Name)
+		if err := migration.Up(ctx, db); err != nil {
+			return count, fmt.Errorf("migration %d (%s) başarısız: %w", migration.Version, migration.Name, err)
+		}
+		record := Record{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}
+		if _, err := records.InsertOne(ctx, record); err != nil {
+			return count, fmt.Errorf("migration %d kaydedilemedi: %w", migration.Version, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// createIndexes koleksiyon indekslerini oluşturur
+func createIndexes(ctx context.Context, db *mongo.Database) error {
+	indexes := map[string][]mongo.IndexModel{
+		"player_states": {
+			// UserID için unique indeks
+			{Keys: bson.D{{Key: "userId", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
+		"highscores": {
+			// Score için azalan sıralama indeksi (leaderboard için)
+			{Keys: bson.D{{Key: "score", Value: -1}}},
+			// DateAchieved için azalan sıralama indeksi
+			{Keys: bson.D{{Key: "dateAchieved", Value: -1}}},
+			// Deste ve stake bazlı liderlik tabloları için bileşik indeksler
+			{Keys: bson.D{{Key: "deck", Value: 1}, {Key: "score", Value: -1}}},
+			{Keys: bson.D{{Key: "stake", Value: 1}, {Key: "score", Value: -1}}},
+		},
+		"runs": {
+			// UserID için indeks (kullanıcının run'larını listelemek için)
+			{Keys: bson.D{{Key: "userId", Value: 1}}},
+		},
+		"profiles": {
+			// UserID için unique indeks (kullanıcı başına tek profil)
+			{Keys: bson.D{{Key: "userId", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
+		"rejected_highscores": {
+			// Gönderim tarihi için indeks (moderasyon listesi için)
+			{Keys: bson.D{{Key: "submittedAt", Value: -1}}},
+		},
+	}
+
+	for collection, list := range indexes {
+		if _, err := db.Collection(collection).Indexes().CreateMany(ctx, list); err != nil {
+			return fmt.Errorf("%s indeksleri oluşturulamadı: %w", collection, err)
+		}
+	}
+	return nil
+}
+
+// upgradePlayerStates güncel şema sürümünün altındaki tüm oyun durumlarını yükseltir
+func upgradePlayerStates(ctx context.Context, db *mongo.Database) error {
+	return UpgradePlayerStates(ctx, db.Collection("player_states"))
+}
+
+// defaultHighscoreDeckAndStake deste ve stake'ten önce kaydedilen skorlara
+// varsayılan değerleri yazar; böylece filtreli liderlik tablolarında görünürler
+func defaultHighscoreDeckAndStake(ctx context.Context, db *mongo.Database) error {
+	collection := db.Collection("highscores")
+	defaults := map[string]string{"deck": decks.Default, "stake": stakes.Default}
+	for field, value := range defaults {
+		filter := bson.M{"$or": bson.A{
+			bson.M{field: bson.M{"$exists": false}},
+			bson.M{field: ""},
+		}}
+		if _, err := collection.UpdateMany(ctx, filter, bson.M{"$set": bson.M{field: value}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createUserIndexes kullanıcı adları için unique indeks oluşturur
+func createUserIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("users").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "username", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
+
+// createGuestIndexes misafir hesaplarının kullanıcı adı olmadığı için
+// kullanıcı adı indeksini yalnızca adı olan belgelere uygular ve cihaz
+// token'ları için unique indeks oluşturur
+func createGuestIndexes(ctx context.Context, db *mongo.Database) error {
+	users := db.Collection("users")
+
+	// Migration 4'ün indeksi; tekrar çalıştırmada zaten silinmiş olabilir
+	if _, err := users.Indexes().DropOne(ctx, "username_1"); err != nil {
+		var commandErr mongo.CommandError
+		if !errors.As(err, &commandErr) || commandErr.Name != "IndexNotFound" {
+			return err
+		}
+	}
+
+	_, err := users.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "username", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("username_unique").
+				SetPartialFilterExpression(bson.M{"username": bson.M{"$exists": true}}),
+		},
+		{
+			Keys: bson.D{{Key: "deviceTokenHash", Value: 1}},
+			Options: options.Index().SetUnique(true).
+				SetPartialFilterExpression(bson.M{"deviceTokenHash": bson.M{"$exists": true}}),
+		},
+	})
+	return err
+}
+
+// createModerationIndexes liderlik tablosu yasakları ve audit log indekslerini oluşturur
+func createModerationIndexes(ctx context.Context, db *mongo.Database) error {
+	indexes := map[string][]mongo.IndexModel{
+		"leaderboard_bans": {
+			// Kullanıcı başına tek yasak kaydı
+			{Keys: bson.D{{Key: "userId", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
+		"admin_audit_log": {
+			// Tarih için azalan sıralama indeksi (audit log listesi için)
+			{Keys: bson.D{{Key: "createdAt", Value: -1}}},
+		},
+	}
+
+	for collection, list := range indexes {
+		if _, err := db.Collection(collection).Indexes().CreateMany(ctx, list); err != nil {
+			return fmt.Errorf("%s indeksleri oluşturulamadı: %w", collection, err)
+		}
+	}
+	return nil
+}
+
+// flagBannedHighscores mevcut yasaklı kullanıcıların skorlarını işaretler;
+// liderlik tablosu sorguları yasak listesi yerine bu alanı kullanır
+func flagBannedHighscores(ctx context.Context, db *mongo.Database) error {
+	userIDs, err := db.Collection("leaderboard_bans").Distinct(ctx, "userId", bson.M{})
+	if err != nil {
+		return err
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+	_, err = db.Collection("highscores").UpdateMany(ctx,
+		bson.M{"userId": bson.M{"$in": userIDs}},
+		bson.M{"$set": bson.M{"banned": true}})
+	return err
+}
+
+// createHighscoreRunIndex aynı run'ın tekrar gönderimlerini bulan sorgu için
+// seed, skor ve blind üzerinde bileşik indeks oluşturur
+func createHighscoreRunIndex(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("highscores").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "seed", Value: 1},
+			{Key: "score", Value: 1},
+			{Key: "finalBlind", Value: 1},
+			{Key: "dateAchieved", Value: 1},
+		},
+	})
+	return err
+}
@@ -0,0 +1,102 @@
+package migrations
+
+import (
+	"context"
+
+	"balatro-backend/game/blinds"
+	"balatro-backend/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// PlayerStateVersion PlayerState belgelerinin güncel şema sürümü. Modele
+// yeni alan eklendiğinde artırılmalı ve playerStateUpgrades'e adım eklenmelidir.
+const PlayerStateVersion = 3
+
+// playerStateUpgrades sırasıyla uygulanan şema adımları: i. adım i sürümündeki
+// belgeyi i+1 sürümüne yükseltir
+var playerStateUpgrades = []func(state *models.PlayerState){
+	// 0 -> 1: ante ve blind türü eklendi; eski kayıtlarda yalnızca blind sırası var
+	func(state *models.PlayerState) {
+		if state.CurrentBlind < 1 {
+			state.CurrentBlind = 1
+		}
+		if state.CurrentAnte < 1 {
+			state.CurrentAnte = (state.CurrentBlind-1)/len(blinds.Order) + 1
+		}
+		if !blinds.IsValidKind(blinds.Kind(state.BlindKind)) {
+			state.BlindKind = string(blinds.Order[(state.CurrentBlind-1)%len(blinds.Order)])
+		}
+	},
+	// 1 -> 2: tarot envanteri, planet seviyeleri ve voucher'lar eklendi; eksik
+	// listeler null yerine boş olmalı
+	func(state *models.PlayerState) {
+		if state.DeckCards == nil {
+			state.DeckCards = []models.Card{}
+		}
+		if state.HandCards == nil {
+			state.HandCards = []models.Card{}
+		}
+		if state.Jokers == nil {
+			state.Jokers = []models.Joker{}
+		}
+		if state.TarotCardsInventory == nil {
+			state.TarotCardsInventory = []models.TarotCard{}
+		}
+		if state.PlanetLevels == nil {
+			state.PlanetLevels = map[string]int{}
+		}
+		if state.VouchersOwned == nil {
+			state.VouchersOwned = []string{}
+		}
+		if state.UnlockedContent == nil {
+			state.UnlockedContent = map[string][]string{}
+		}
+	},
+	// 2 -> 3: planet kartları envanteri eklendi
+	func(state *models.PlayerState) {
+		if state.PlanetCardsInventory == nil {
+			state.PlanetCardsInventory = []models.PlanetCard{}
+		}
+	},
+}
+
+// UpgradePlayerState eski sürümdeki oyun durumunu güncel şemaya yükseltir.
+// Durum değiştiyse true döner.
+func UpgradePlayerState(state *models.PlayerState) bool {
+	if state.SchemaVersion >= PlayerStateVersion {
+		return false
+	}
+	for version := state.SchemaVersion; version < PlayerStateVersion; version++ {
+		playerStateUpgrades[version](state)
+	}
+	state.SchemaVersion = PlayerStateVersion
+	return true
+}
+
+// UpgradePlayerStates koleksiyondaki güncel sürümün altındaki tüm oyun
+// durumlarını yükseltip geri yazar
+func UpgradePlayerStates(ctx context.Context, collection *mongo.Collection) error {
+	filter := bson.M{"$or": bson.A{
+		bson.M{"schemaVersion": bson.M{"$exists": false}},
+		bson.M{"schemaVersion": bson.M{"$lt": PlayerStateVersion}},
+	}}
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var state models.PlayerState
+		if err := cursor.Decode(&state); err != nil {
+			return err
+		}
+		UpgradePlayerState(&state)
+		if _, err := collection.ReplaceOne(ctx, bson.M{"_id": state.ID}, state); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
@@ -0,0 +1,98 @@
+package migrations
+
+import (
+	"reflect"
+	"testing"
+
+	"balatro-backend/models"
+)
+
+// emptyLists 1 -> 2 ve 2 -> 3 adımlarının eklediği boş listeler
+func emptyLists(state models.PlayerState) models.PlayerState {
+	state.DeckCards = []models.Card{}
+	state.HandCards = []models.Card{}
+	state.Jokers = []models.Joker{}
+	state.TarotCardsInventory = []models.TarotCard{}
+	state.PlanetCardsInventory = []models.PlanetCard{}
+	state.PlanetLevels = map[string]int{}
+	state.VouchersOwned = []string{}
+	state.UnlockedContent = map[string][]string{}
+	return state
+}
+
+func TestUpgradePlayerState(t *testing.T) {
+	ace := models.Card{Suit: "SPADES", Value: "ACE", Enhancements: []models.Enhancement{}}
+
+	tests := []struct {
+		name string
+		in   models.PlayerState
+		want models.PlayerState
+	}{
+		{
+			name: "v0 without blind",
+			in:   models.PlayerState{Money: 4},
+			want: emptyLists(models.PlayerState{Money: 4, CurrentBlind: 1, CurrentAnte: 1, BlindKind: "SMALL"}),
+		},
+		{
+			name: "v0 backfills ante and blind kind from blind order",
+			in:   models.PlayerState{CurrentBlind: 5},
+			want: emptyLists(models.PlayerState{CurrentBlind: 5, CurrentAnte: 2, BlindKind: "BIG"}),
+		},
+		{
+			name: "v0 keeps a stored ante and blind kind",
+			in:   models.PlayerState{CurrentBlind: 3, CurrentAnte: 4, BlindKind: "BOSS"},
+			want: emptyLists(models.PlayerState{CurrentBlind: 3, CurrentAnte: 4, BlindKind: "BOSS"}),
+		},
+		{
+			name: "v1 turns nil lists into empty ones",
+			in:   models.PlayerState{SchemaVersion: 1, CurrentBlind: 2, CurrentAnte: 1, BlindKind: "BIG"},
+			want: emptyLists(models.PlayerState{CurrentBlind: 2, CurrentAnte: 1, BlindKind: "BIG"}),
+		},
+		{
+			name: "v1 keeps stored lists",
+			in: models.PlayerState{
+				SchemaVersion: 1, CurrentBlind: 1, CurrentAnte: 1, BlindKind: "SMALL",
+				DeckCards:    []models.Card{ace},
+				PlanetLevels: map[string]int{"Flush": 2},
+			},
+			want: func() models.PlayerState {
+				state := emptyLists(models.PlayerState{CurrentBlind: 1, CurrentAnte: 1, BlindKind: "SMALL"})
+				state.DeckCards = []models.Card{ace}
+				state.PlanetLevels = map[string]int{"Flush": 2}
+				return state
+			}(),
+		},
+		{
+			name: "v2 adds an empty planet card inventory",
+			in: func() models.PlayerState {
+				state := emptyLists(models.PlayerState{SchemaVersion: 2, CurrentBlind: 1, CurrentAnte: 1, BlindKind: "SMALL"})
+				state.PlanetCardsInventory = nil
+				return state
+			}(),
+			want: emptyLists(models.PlayerState{CurrentBlind: 1, CurrentAnte: 1, BlindKind: "SMALL"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tt.in
+			if !UpgradePlayerState(&state) {
+				t.Fatal("UpgradePlayerState = false, want true")
+			}
+			tt.want.SchemaVersion = PlayerStateVersion
+			if !reflect.DeepEqual(state, tt.want) {
+				t.Errorf("upgraded =\n%+v\nwant\n%+v", state, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpgradePlayerStateLeavesCurrentVersion(t *testing.T) {
+	state := models.PlayerState{SchemaVersion: PlayerStateVersion, CurrentBlind: 0}
+	if UpgradePlayerState(&state) {
+		t.Fatal("UpgradePlayerState = true for a current document")
+	}
+	if !reflect.DeepEqual(state, models.PlayerState{SchemaVersion: PlayerStateVersion}) {
+		t.Errorf("current document changed: %+v", state)
+	}
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Şüpheli skor nedenleri
+const (
+	SuspicionImpossibleScore = "impossible_score" // Skor, ulaşılan blind için mümkün değil
+	SuspicionTooManyJokers   = "too_many_jokers"  // Joker sayısı slot sınırını aşıyor
+	SuspicionUnknownJoker    = "unknown_joker"    // Tanımlı olmayan joker
+	SuspicionDuplicate       = "duplicate"        // Aynı run daha önce gönderilmiş
+)
+
+// Moderasyon aksiyonları
+const (
+	ModerationFlag    = "flag"
+	ModerationUnflag  = "unflag"
+	ModerationDelete  = "delete"
+	ModerationRestore = "restore"
+)
+
+// Audit log aksiyonları
+const (
+	AuditHighscoreModerated = "highscore_moderated"
+	AuditUserBanned         = "user_banned"
+	AuditUserUnbanned       = "user_unbanned"
+)
+
+// SuspiciousHighscore şüpheli bulunan skor ve nedenleri
+type SuspiciousHighscore struct {
+	Highscore Highscore `json:"highscore"`
+	Reasons   []string  `json:"reasons"`
+	Detail    []string  `json:"detail"` // Her neden için açıklama
+}
+
+// LeaderboardBan liderlik tablolarından yasaklanmış kullanıcı
+type LeaderboardBan struct {
+	ID        primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	UserID    string             `json:"userId" bson:"userId"`
+	Reason    string             `json:"reason" bson:"reason"`
+	BannedBy  string             `json:"bannedBy" bson:"bannedBy"` // Yasaklayan admin
+	CreatedAt time.Time          `json:"createdAt" bson:"createdAt"`
+}
+
+// AuditLogEntry bir admin işleminin kaydı
+type AuditLogEntry struct {
+	ID        primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	Admin     string             `json:"admin" bson:"admin"`   // "user:<id>" veya "api-key"
+	Action    string             `json:"action" bson:"action"` // Audit* sabitlerinden biri
+	Target    string             `json:"target" bson:"target"` // Etkilenen skor veya kullanıcı ID'si
+	Detail    string             `json:"detail,omitempty" bson:"detail,omitempty"`
+	CreatedAt time.Time          `json:"createdAt" bson:"createdAt"`
+}
+
+// ModerateHighscoreRequest skor moderasyon request'i
+type ModerateHighscoreRequest struct {
+	Action string `json:"action" binding:"required,oneof=flag unflag delete restore"`
+	Reason string `json:"reason" binding:"max=500"`
+}
+
+// BanUserRequest liderlik tablosu yasağı request'i
+type BanUserRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
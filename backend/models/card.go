@@ -5,24 +5,42 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// Enhancement kart enhancement tipi ("WILD", "GLASS", "STEEL", ...)
+type Enhancement string
+
+// Edition kart edition tipi ("FOIL", "HOLOGRAPHIC", "POLYCHROME")
+type Edition string
+
+// Seal kart mühür tipi ("GOLD", "RED", "BLUE", "PURPLE")
+type Seal string
+
 // Card temel kart yapısı
 type Card struct {
-	Suit         string   `json:"suit" bson:"suit"`                 // "SPADES", "HEARTS", "DIAMONDS", "CLUBS"
-	Value        string   `json:"value" bson:"value"`               // "2", "3", ..., "10", "JACK", "QUEEN", "KING", "ACE"
-	Enhancements []string `json:"enhancements" bson:"enhancements"` // Enhancement listesi
+	Suit         string        `json:"suit" bson:"suit" binding:"required,suit"`                               // "SPADES", "HEARTS", "DIAMONDS", "CLUBS"
+	Value        string        `json:"value" bson:"value" binding:"required,card_value"`                       // "2", "3", ..., "10", "JACK", "QUEEN", "KING", "ACE"
+	Enhancements []Enhancement `json:"enhancements" bson:"enhancements" binding:"dive,enhancement"`            // Enhancement listesi
+	Edition      Edition       `json:"edition,omitempty" bson:"edition,omitempty" binding:"omitempty,edition"` // Kart edition'ı (yoksa boş)
+	Seal         Seal          `json:"seal,omitempty" bson:"seal,omitempty" binding:"omitempty,seal"`          // Kart mühürü (yoksa boş)
 }
 
 // Joker joker kartı yapısı
 type Joker struct {
-	ID       string                 `json:"id" bson:"id"`             // "joker_greedy", "joker_multiplier"
-	Level    int                    `json:"level" bson:"level"`       // Joker seviyesi/gücü
+	ID       string                 `json:"id" bson:"id" binding:"required,joker_id"`   // "joker_greedy", "joker_multiplier"
+	Level    int                    `json:"level" bson:"level" binding:"min=0,max=100"` // Joker seviyesi/gücü
 	IsActive bool                   `json:"is_active" bson:"is_active"` // Aktif mi pasif mi
 	Stats    map[string]interface{} `json:"stats" bson:"stats"`       // Joker istatistikleri
+	Eternal  bool                   `json:"eternal,omitempty" bson:"eternal,omitempty"` // Satılamaz ve yok edilemez (Black stake)
 }
 
 // TarotCard tarot kartı yapısı
 type TarotCard struct {
-	ID       string `json:"id" bson:"id"`             // "tarot_fool", "tarot_magician"
+	ID       string `json:"id" bson:"id" binding:"required,tarot_id"`        // "tarot_fool", "tarot_magician"
+	Quantity int    `json:"quantity" bson:"quantity" binding:"min=1,max=99"` // Envanterdeki sayısı
+}
+
+// PlanetCard envanterdeki planet kartı
+type PlanetCard struct {
+	ID       string `json:"id" bson:"id"`             // "planet_mercury"
 	Quantity int    `json:"quantity" bson:"quantity"` // Envanterdeki sayısı
 }
 
@@ -30,8 +48,12 @@ type TarotCard struct {
 type PlayerState struct {
 	ID                    primitive.ObjectID    `json:"_id,omitempty" bson:"_id,omitempty"`
 	UserID                string                `json:"userId" bson:"userId"`                             // Kullanıcı ID'si (şimdilik string)
+	SchemaVersion         int                   `json:"schemaVersion" bson:"schemaVersion"`               // Belge şema sürümü (eski kayıtlar yüklenirken yükseltilir)
 	CurrentScore          int64                 `json:"currentScore" bson:"currentScore"`                 // Mevcut skor
 	CurrentBlind          int                   `json:"currentBlind" bson:"currentBlind"`                 // Hangi körde
+	CurrentAnte           int                   `json:"currentAnte" bson:"currentAnte"`                   // Hangi ante'de
+	BlindKind             string                `json:"blindKind" bson:"blindKind"`                       // "SMALL", "BIG", "BOSS"
+	BossBlindID           string                `json:"bossBlindId,omitempty" bson:"bossBlindId,omitempty"` // Ante'nin boss blind'ı
 	Money                 int                   `json:"money" bson:"money"`                               // Para birimi
 	Lives                 int                   `json:"lives" bson:"lives"`                               // Kalan can
 	DiscardsLeft          int                   `json:"discardsLeft" bson:"discardsLeft"`                 // Kalan discard hakkı
@@ -40,9 +62,12 @@ type PlayerState struct {
 	HandCards             []Card                `json:"handCards" bson:"handCards"`                       // Eldeki kartlar
 	Jokers                []Joker               `json:"jokers" bson:"jokers"`                             // Sahip olunan jokerler
 	TarotCardsInventory   []TarotCard           `json:"tarotCardsInventory" bson:"tarotCardsInventory"`   // Tarot kartları envanteri
+	PlanetCardsInventory  []PlanetCard          `json:"planetCardsInventory" bson:"planetCardsInventory"` // Planet kartları envanteri
 	PlanetLevels          map[string]int        `json:"planetLevels" bson:"planetLevels"`                 // Poker eli seviyeleri
 	VouchersOwned         []string              `json:"vouchersOwned" bson:"vouchersOwned"`               // Sahip olunan voucher'lar
 	UnlockedContent       map[string][]string   `json:"unlockedContent" bson:"unlockedContent"`           // Kilidi açılmış içerikler
+	TarotSeed             string                `json:"tarotSeed,omitempty" bson:"tarotSeed,omitempty"`   // Tarot efektlerinin RNG seed'i
+	TarotsUsed            int                   `json:"tarotsUsed,omitempty" bson:"tarotsUsed,omitempty"` // Kullanılan tarot sayısı (seed akışı için)
 	LastPlayedTimestamp   time.Time             `json:"lastPlayedTimestamp" bson:"lastPlayedTimestamp"`   // Son oynanma zamanı
 }
 
@@ -56,37 +81,117 @@ type Highscore struct {
 	FinalBlind   int                `json:"finalBlind" bson:"finalBlind"`     // Hangi körde bitti
 	JokersUsed   []string           `json:"jokersUsed" bson:"jokersUsed"`     // Kullanılan joker ID'leri
 	Seed         string             `json:"seed" bson:"seed"`                 // Oyun seed'i (varsa)
+	Deck         string             `json:"deck" bson:"deck"`                 // Başlangıç destesi
+	Stake        string             `json:"stake" bson:"stake"`               // Zorluk stake'i
+
+	// Moderasyon: işaretlenen veya silinen skorlar liderlik tablolarında görünmez
+	Flagged    bool       `json:"flagged,omitempty" bson:"flagged,omitempty"`
+	FlagReason string     `json:"flagReason,omitempty" bson:"flagReason,omitempty"`
+	DeletedAt  *time.Time `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"` // Soft-delete zamanı
+	Banned     bool       `json:"banned,omitempty" bson:"banned,omitempty"`       // Sahibi liderlik tablolarından yasaklı
 }
 
-// CreatePlayerStateRequest oyun durumu oluşturma/güncelleme request'i
+// CreatePlayerStateRequest oyun durumu oluşturma/güncelleme request'i.
+// Kart sayısı ve joker slotu gibi oyun kuralları validation.PlayerState ile ayrıca doğrulanır.
 type CreatePlayerStateRequest struct {
-	UserID       string                `json:"userId" binding:"required"`
-	CurrentScore int64                 `json:"currentScore"`
-	CurrentBlind int                   `json:"currentBlind"`
-	Money        int                   `json:"money"`
-	Lives        int                   `json:"lives"`
-	DiscardsLeft int                   `json:"discardsLeft"`
-	HandsLeft    int                   `json:"handsLeft"`
-	DeckCards    []Card                `json:"deckCards"`
-	HandCards    []Card                `json:"handCards"`
-	Jokers       []Joker               `json:"jokers"`
-	PlanetLevels map[string]int        `json:"planetLevels"`
+	UserID              string         `json:"userId"` // Yok sayılır, oturumdaki kullanıcı kullanılır
+	CurrentScore        int64          `json:"currentScore" binding:"min=0"`
+	CurrentBlind        int            `json:"currentBlind" binding:"min=0,max=1000"`
+	CurrentAnte         int            `json:"currentAnte" binding:"min=0,max=100"`
+	BlindKind           string         `json:"blindKind" binding:"omitempty,blind_kind"`
+	Money               int            `json:"money" binding:"min=0,max=1000000"`
+	Lives               int            `json:"lives" binding:"min=0,max=10"`
+	DiscardsLeft        int            `json:"discardsLeft" binding:"min=0,max=20"`
+	HandsLeft           int            `json:"handsLeft" binding:"min=0,max=20"`
+	DeckCards           []Card         `json:"deckCards" binding:"dive"`
+	HandCards           []Card         `json:"handCards" binding:"dive"`
+	Jokers              []Joker        `json:"jokers" binding:"dive"`
+	TarotCardsInventory []TarotCard    `json:"tarotCardsInventory" binding:"dive"`
+	PlanetLevels        map[string]int `json:"planetLevels" binding:"dive,keys,hand_name,endkeys,min=0,max=100"`
+	VouchersOwned       []string       `json:"vouchersOwned" binding:"unique,dive,voucher_id"`
+}
+
+// UseTarotRequest tarot kartı kullanma request'i
+type UseTarotRequest struct {
+	TarotID string `json:"tarotId" binding:"required"` // "tarot_fool"
+	Targets []int  `json:"targets"`                    // Hedef el kartlarının indeksleri
+}
+
+// UsePlanetRequest planet kartı kullanma request'i
+type UsePlanetRequest struct {
+	PlanetID string `json:"planetId" binding:"required"` // "planet_mercury"
 }
 
 // CreateHighscoreRequest yüksek skor oluşturma request'i
 type CreateHighscoreRequest struct {
-	UserID     string   `json:"userId" binding:"required"`
-	PlayerName string   `json:"playerName" binding:"required"`
-	Score      int64    `json:"score" binding:"required"`
-	FinalBlind int      `json:"finalBlind"`
-	JokersUsed []string `json:"jokersUsed"`
-	Seed       string   `json:"seed"`
+	UserID     string         `json:"userId"`     // Yok sayılır, oturumdaki kullanıcı kullanılır
+	PlayerName string         `json:"playerName"` // Boşsa hesabın görünen adı; misafirlerde her zaman görünen ad
+	Score      int64          `json:"score" binding:"min=0"`
+	FinalBlind int            `json:"finalBlind"`
+	JokersUsed []string       `json:"jokersUsed"`
+	RunID      string         `json:"runId" binding:"required"` // Skorun elde edildiği run (seed, deste, stake ve kilitler buradan alınır)
+	Actions    []ReplayAction `json:"actions"`                  // Run'ın sıralı aksiyon kaydı (doğrulama için)
+}
+
+// ReplayAction run'daki tek bir oyuncu aksiyonu
+type ReplayAction struct {
+	Type    string `json:"type" bson:"type"`                           // "play", "discard", "buy", "reroll", "open_pack", "pick_pack", "use_tarot", "advance"
+	Cards   []int  `json:"cards,omitempty" bson:"cards,omitempty"`     // play/discard: eldeki kart indeksleri
+	Slot    int    `json:"slot,omitempty" bson:"slot,omitempty"`       // buy: dükkan slotu
+	Index   int    `json:"index,omitempty" bson:"index,omitempty"`     // open_pack: paket sırası
+	Choices []int  `json:"choices,omitempty" bson:"choices,omitempty"` // pick_pack: seçilen teklifler
+	ItemID  string `json:"itemId,omitempty" bson:"itemId,omitempty"`   // use_tarot: tarot ID'si
+	Targets []int  `json:"targets,omitempty" bson:"targets,omitempty"` // use_tarot: hedef el kartları
+}
+
+// RejectedHighscore doğrulanamayan yüksek skor gönderimi (moderasyon için saklanır)
+type RejectedHighscore struct {
+	ID                 primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	UserID             string             `json:"userId" bson:"userId"`
+	PlayerName         string             `json:"playerName" bson:"playerName"`
+	ClaimedScore       int64              `json:"claimedScore" bson:"claimedScore"`             // İstemcinin gönderdiği skor
+	ClaimedFinalBlind  int                `json:"claimedFinalBlind" bson:"claimedFinalBlind"`   // İstemcinin gönderdiği blind
+	ComputedScore      int64              `json:"computedScore" bson:"computedScore"`           // Sunucunun hesapladığı skor
+	ComputedFinalBlind int                `json:"computedFinalBlind" bson:"computedFinalBlind"` // Sunucunun hesapladığı blind
+	RunID              string             `json:"runId" bson:"runId"`
+	Seed               string             `json:"seed" bson:"seed"`
+	Deck               string             `json:"deck" bson:"deck"`
+	Stake              string             `json:"stake" bson:"stake"`
+	Actions            []ReplayAction     `json:"actions" bson:"actions"`
+	Reason             string             `json:"reason" bson:"reason"` // Reddedilme nedeni
+	Detail             string             `json:"detail" bson:"detail"` // Ayrıntılı açıklama
+	SubmittedAt        time.Time          `json:"submittedAt" bson:"submittedAt"`
+}
+
+// CalculateScoreRequest el puanı hesaplama request'i
+type CalculateScoreRequest struct {
+	PlayedCards  []Card         `json:"playedCards" binding:"required,max=5,dive"` // Oynanan el en fazla 5 kart
+	HeldCards    []Card         `json:"heldCards" binding:"dive"`
+	Jokers       []Joker        `json:"jokers"`
+	PlanetLevels map[string]int `json:"planetLevels"`
+}
+
+// CheckBlindRequest skorun blind'ı geçip geçmediğini kontrol etme request'i
+type CheckBlindRequest struct {
+	Ante  int    `json:"ante" binding:"required"`
+	Kind  string `json:"kind" binding:"required"` // "SMALL", "BIG", "BOSS"
+	Score int64  `json:"score"`
+	Seed  string `json:"seed"`  // Boss blind modifikasyonu için (opsiyonel)
+	Stake string `json:"stake"` // Stake hedef etkisi için (opsiyonel, varsayılan white)
 }
 
 // APIResponse genel API yanıt yapısı
 type APIResponse struct {
-	Success bool        `json:"success"`
-	Message string      `json:"message"`
-	Data    interface{} `json:"data,omitempty"`
-	Error   string      `json:"error,omitempty"`
+	Success bool         `json:"success"`
+	Message string       `json:"message"`
+	Data    interface{}  `json:"data,omitempty"`
+	Error   string       `json:"error,omitempty"`
+	Errors  []FieldError `json:"errors,omitempty"` // Alan bazında doğrulama hataları
+}
+
+// FieldError request'teki tek bir alanın doğrulama hatası
+type FieldError struct {
+	Field   string `json:"field"`   // JSON yolu, ör. "deckCards[3].suit"; gövdenin tamamı için boş
+	Code    string `json:"code"`    // Makinece okunabilir kod, ör. "required", "max", "suit"
+	Message string `json:"message"` // Kullanıcıya gösterilebilecek açıklama
 }
\ No newline at end of file
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ProfileStats kullanıcının tüm run'lar boyunca biriken istatistikleri
+type ProfileStats struct {
+	RunsPlayed  int   `json:"runsPlayed" bson:"runsPlayed"`   // Biten run sayısı
+	RunsWon     int   `json:"runsWon" bson:"runsWon"`         // Kazanılan run sayısı
+	BestScore   int64 `json:"bestScore" bson:"bestScore"`     // Tek run'daki en yüksek skor
+	HighestAnte int   `json:"highestAnte" bson:"highestAnte"` // Ulaşılan en yüksek ante
+}
+
+// Profile kullanıcının run'lardan bağımsız kalıcı ilerlemesi
+type Profile struct {
+	ID         primitive.ObjectID  `json:"_id,omitempty" bson:"_id,omitempty"`
+	UserID     string              `json:"userId" bson:"userId"`
+	Unlocked   map[string][]string `json:"unlocked" bson:"unlocked"`     // Kategori -> kilidi açılmış öğe ID'leri
+	Discovered map[string][]string `json:"discovered" bson:"discovered"` // Kategori -> run'da kullanılmış öğe ID'leri
+	Stats      ProfileStats        `json:"stats" bson:"stats"`
+	CreatedAt  time.Time           `json:"createdAt" bson:"createdAt"`
+	UpdatedAt  time.Time           `json:"updatedAt" bson:"updatedAt"`
+}
@@ -0,0 +1,156 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Run fazları
+const (
+	PhaseBlindSelect = "BLIND_SELECT" // Sonraki blind seçiliyor
+	PhasePlaying     = "PLAYING"      // Blind oynanıyor
+	PhaseShop        = "SHOP"         // Dükkanda
+	PhaseGameOver    = "GAME_OVER"    // Oyun bitti
+)
+
+// ShopItem dükkandaki tek bir satış öğesi
+type ShopItem struct {
+	Slot    int    `json:"slot" bson:"slot"`     // Dükkan içindeki sıra
+	Type    string `json:"type" bson:"type"`     // "joker", "tarot", "planet", "pack"
+	ItemID  string `json:"itemId" bson:"itemId"` // "red_card"
+	Name    string `json:"name" bson:"name"`
+	Price   int    `json:"price" bson:"price"`
+	Rarity  string `json:"rarity" bson:"rarity"`
+	Eternal bool   `json:"eternal,omitempty" bson:"eternal,omitempty"` // Eternal joker (satılamaz, yok edilemez)
+	Sold    bool   `json:"sold" bson:"sold"`
+}
+
+// PackOffer açılan paketteki tek bir seçenek
+type PackOffer struct {
+	Type   string `json:"type" bson:"type"`                         // "tarot", "planet", "card"
+	ItemID string `json:"itemId,omitempty" bson:"itemId,omitempty"` // Tarot/planet ID'si
+	Name   string `json:"name" bson:"name"`
+	Card   *Card  `json:"card,omitempty" bson:"card,omitempty"` // Standard paketteki oyun kartı
+	Picked bool   `json:"picked" bson:"picked"`
+}
+
+// OpenPack açılmış ve seçim bekleyen paket
+type OpenPack struct {
+	PackID    string      `json:"packId" bson:"packId"`
+	Offers    []PackOffer `json:"offers" bson:"offers"`
+	PicksLeft int         `json:"picksLeft" bson:"picksLeft"` // Kalan seçim hakkı
+}
+
+// Run sunucu tarafında yürütülen tek bir oyun
+type Run struct {
+	ID            primitive.ObjectID  `json:"_id,omitempty" bson:"_id,omitempty"`
+	UserID        string              `json:"userId" bson:"userId"`
+	Seed          string              `json:"seed" bson:"seed"`                   // Deterministik RNG seed'i
+	SeedGenerated bool                `json:"seedGenerated" bson:"seedGenerated"` // Seed sunucuda rastgele üretildi mi (oyuncu seçmediyse)
+	DeckID        string              `json:"deckId" bson:"deckId"`               // Başlangıç destesi ("red", "abandoned", ...)
+	StakeID       string              `json:"stakeId" bson:"stakeId"`             // Zorluk stake'i ("white", ..., "gold")
+	RNGVersion    int                 `json:"rngVersion" bson:"rngVersion"`       // Seed'in üretildiği RNG sürümü
+	Phase         string              `json:"phase" bson:"phase"`                 // BLIND_SELECT, PLAYING, SHOP, GAME_OVER
+	Round         int                 `json:"round" bson:"round"`                 // Başlatılan blind sayısı (deste karıştırma için)
+	RoundScore    int64               `json:"roundScore" bson:"roundScore"`       // Mevcut blind'da toplanan skor
+	HandsPlayed   int                 `json:"handsPlayed" bson:"handsPlayed"`     // Mevcut blind'da oynanan el sayısı
+	Deck          []Card              `json:"deck" bson:"deck"`                   // Oyuncunun sahip olduğu tüm kartlar
+	State         PlayerState         `json:"state" bson:"state"`                 // Oyuncunun mevcut durumu
+	Shop          []ShopItem          `json:"shop" bson:"shop"`                   // Mevcut dükkan öğeleri
+	ShopVisit     int                 `json:"shopVisit" bson:"shopVisit"`         // Mevcut dükkanın açıldığı blind (seed akışı için)
+	Rerolls       int                 `json:"rerolls" bson:"rerolls"`             // Mevcut dükkanda yapılan reroll sayısı
+	RerollCost    int                 `json:"rerollCost" bson:"rerollCost"`       // Sıradaki reroll ücreti
+	Packs         []string            `json:"packs" bson:"packs"`                 // Satın alınmış, henüz açılmamış paketler
+	PacksOpened   int                 `json:"packsOpened" bson:"packsOpened"`     // Açılan paket sayısı (seed akışı için)
+	OpenPack      *OpenPack           `json:"openPack" bson:"openPack"`           // Seçim bekleyen açık paket
+	TarotsUsed    int                 `json:"tarotsUsed" bson:"tarotsUsed"`       // Kullanılan tarot sayısı (seed akışı için)
+	VoucherAnte   int                 `json:"voucherAnte" bson:"voucherAnte"`     // En son voucher alınan ante (ante başına bir voucher)
+	Locked        map[string][]string `json:"locked" bson:"locked,omitempty"`     // Run başladığında profilde kilitli öğeler (dükkanda sunulmaz)
+	HandCounts    map[string]int      `json:"handCounts" bson:"handCounts"`       // El adı -> run boyunca oynanma sayısı
+	Recorded      bool                `json:"recorded" bson:"recorded"`           // Run sonucu profile işlendi mi (bir kez sayılır)
+	CashOut       *CashOut            `json:"cashOut" bson:"cashOut"`             // Son tamamlanan blind'ın ödeme dökümü
+	Version       int                 `json:"version" bson:"version"`             // Eşzamanlı güncellemeler için sürüm
+	CreatedAt     time.Time           `json:"createdAt" bson:"createdAt"`
+	UpdatedAt     time.Time           `json:"updatedAt" bson:"updatedAt"`
+}
+
+// RunView run'ın istemciye gösterilen hali. Sunucunun ürettiği seed gelecekteki
+// çekilişleri, dükkanı ve paketleri öngörmeyi sağlayacağı için yalnızca run
+// bittiğinde (GAME_OVER) eklenir; oyuncunun kendi seçtiği seed zaten bilinir.
+type RunView struct {
+	ID                  primitive.ObjectID  `json:"_id"`
+	Phase               string              `json:"phase"`
+	DeckID              string              `json:"deckId"`
+	StakeID             string              `json:"stakeId"`
+	Round               int                 `json:"round"`
+	RoundScore          int64               `json:"roundScore"`
+	HandsPlayed         int                 `json:"handsPlayed"`
+	CurrentAnte         int                 `json:"currentAnte"`
+	BlindKind           string              `json:"blindKind"`
+	Money               int                 `json:"money"`
+	Lives               int                 `json:"lives"`
+	HandsLeft           int                 `json:"handsLeft"`
+	DiscardsLeft        int                 `json:"discardsLeft"`
+	HandCards           []Card              `json:"handCards"`
+	DeckRemaining       int                 `json:"deckRemaining"` // Destede kalan kart sayısı
+	DeckSize            int                 `json:"deckSize"`      // Sahip olunan toplam kart sayısı
+	Jokers              []Joker             `json:"jokers"`
+	TarotCardsInventory []TarotCard         `json:"tarotCardsInventory"`
+	PlanetLevels        map[string]int      `json:"planetLevels"`
+	VouchersOwned       []string            `json:"vouchersOwned"`
+	Shop                []ShopItem          `json:"shop"`
+	RerollCost          int                 `json:"rerollCost"`
+	Packs               []string            `json:"packs"`
+	OpenPack            *OpenPack           `json:"openPack"`
+	HandCounts          map[string]int      `json:"handCounts"`
+	CashOut             *CashOut            `json:"cashOut"`
+	Locked              map[string][]string `json:"locked"` // Run başındaki kilitli öğeler (dükkanda sunulmaz)
+	Version             int                 `json:"version"`
+	CreatedAt           time.Time           `json:"createdAt"`
+	UpdatedAt           time.Time           `json:"updatedAt"`
+	Deck                []Card              `json:"deck"`           // Sahip olunan kartlar (çekiliş sırası değil)
+	Seed                string              `json:"seed,omitempty"` // Sunucu üretmişse yalnızca GAME_OVER
+}
+
+// CashOutItem blind sonu ödemesindeki tek bir kalem
+type CashOutItem struct {
+	Kind   string `json:"kind" bson:"kind"`                         // "blind", "hands", "interest", "gold_card", "joker"
+	Source string `json:"source,omitempty" bson:"source,omitempty"` // Joker ID'si veya kart adı
+	Count  int    `json:"count,omitempty" bson:"count,omitempty"`   // Kullanılmayan el sayısı vb.
+	Money  int    `json:"money" bson:"money"`
+}
+
+// CashOut blind tamamlandığında ödenen paranın kalem kalem dökümü
+type CashOut struct {
+	Items []CashOutItem `json:"items" bson:"items"`
+	Total int           `json:"total" bson:"total"`
+}
+
+// CreateRunRequest yeni run oluşturma request'i
+type CreateRunRequest struct {
+	UserID string `json:"userId"` // Yok sayılır, oturumdaki kullanıcı kullanılır
+	Seed   string `json:"seed"`   // Boşsa rastgele üretilir
+	Deck   string `json:"deck"`   // Boşsa varsayılan deste (red)
+	Stake  string `json:"stake"`  // Boşsa varsayılan stake (white)
+}
+
+// CardSelectionRequest oynanacak/discard edilecek kartların eldeki indeksleri
+type CardSelectionRequest struct {
+	Cards []int `json:"cards" binding:"required"`
+}
+
+// PackOpenRequest satın alınmış paketi açma request'i
+type PackOpenRequest struct {
+	Index int `json:"index"` // Run.Packs içindeki sıra
+}
+
+// PackPickRequest açık paketten seçim request'i (boş liste paketi atlar)
+type PackPickRequest struct {
+	Choices []int `json:"choices"` // Seçilen teklif indeksleri
+}
+
+// ShopBuyRequest dükkandan satın alma request'i
+type ShopBuyRequest struct {
+	Slot int `json:"slot"`
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RoleAdmin yönetim API'sine erişebilen kullanıcı rolü
+const RoleAdmin = "admin"
+
+// User kayıtlı oyuncu veya misafir hesabı
+type User struct {
+	ID              primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	Username        string             `json:"username,omitempty" bson:"username,omitempty"` // Küçük harfe çevrilmiş, benzersiz (misafirlerde boş)
+	DisplayName     string             `json:"displayName" bson:"displayName"`               // Liderlik tablolarında görünen ad
+	Guest           bool               `json:"guest" bson:"guest"`
+	Role            string             `json:"role,omitempty" bson:"role,omitempty"` // Boş veya RoleAdmin
+	PasswordHash    string             `json:"-" bson:"passwordHash,omitempty"`      // bcrypt özeti (yanıtlarda gönderilmez)
+	DeviceTokenHash string             `json:"-" bson:"deviceTokenHash,omitempty"`   // Misafir cihaz token'ının SHA-256 özeti
+	CreatedAt       time.Time          `json:"createdAt" bson:"createdAt"`
+}
+
+// RegisterRequest hesap oluşturma request'i (misafir hesabını yükseltirken de kullanılır)
+type RegisterRequest struct {
+	Username string `json:"username" binding:"required,min=3,max=32"`
+	Password string `json:"password" binding:"required,min=8,max=72"` // bcrypt en fazla 72 byte kullanır (byte sınırı handler'da kontrol edilir)
+}
+
+// LoginRequest giriş request'i
+type LoginRequest struct {
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// GuestRequest misafir oturumu request'i; cihaz token'ı boşsa yeni misafir oluşturulur
+type GuestRequest struct {
+	DeviceToken string `json:"deviceToken"`
+}
+
+// AuthResponse başarılı kayıt/giriş yanıtı
+type AuthResponse struct {
+	Token       string    `json:"token"`                 // Authorization: Bearer <token> olarak gönderilir
+	ExpiresAt   time.Time `json:"expiresAt"`             // Token'ın geçerlilik sonu
+	DeviceToken string    `json:"deviceToken,omitempty"` // Yalnızca yeni misafir oluşturulurken döner; cihazda saklanmalı
+	User        User      `json:"user"`
+}
@@ -0,0 +1,84 @@
+package moderation
+
+import (
+	"fmt"
+	"math"
+
+	"balatro-backend/game/blinds"
+	"balatro-backend/game/jokers"
+	"balatro-backend/game/run"
+	"balatro-backend/game/stakes"
+	"balatro-backend/models"
+)
+
+// ImplausibleScoreFactor skorun, ulaşılan blind'a kadarki hedeflerin toplamının
+// kaç katını aşması halinde şüpheli sayılacağı
+const ImplausibleScoreFactor = 1000
+
+// blindsPerAnte bir ante'deki blind sayısı
+var blindsPerAnte = len(blinds.Order)
+
+// blindAt blind sıra numarasının (1'den başlar) ante'sini ve türünü döndürür
+func blindAt(blind int) (int, blinds.Kind) {
+	return (blind-1)/blindsPerAnte + 1, blinds.Order[(blind-1)%blindsPerAnte]
+}
+
+// MinimumScore finalBlind'a ulaşmak için gereken en düşük toplam skoru,
+// yani önceki tüm blind'ların en düşük hedeflerinin toplamını döndürür
+func MinimumScore(finalBlind int) int64 {
+	var total int64
+	for blind := 1; blind < finalBlind; blind++ {
+		total += blinds.MinimumTarget(blindAt(blind))
+	}
+	return total
+}
+
+// MaximumPlausibleScore finalBlind dahil tüm blind'ların (stake'siz) hedefleri
+// toplamının ImplausibleScoreFactor katını döndürür
+func MaximumPlausibleScore(finalBlind int) int64 {
+	var total float64
+	for blind := 1; blind <= finalBlind; blind++ {
+		ante, kind := blindAt(blind)
+		total += float64(blinds.Target(ante, kind, nil, stakes.Modifiers{}))
+	}
+	limit := total * ImplausibleScoreFactor
+	if limit > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(limit)
+}
+
+// Check tek bir skoru inceler ve şüpheliyse nedenleriyle döndürür. original
+// nil değilse skor, aynı run'ın daha önceki bir gönderiminin (original) tekrarıdır;
+// tekrarlar depoda tüm skorlar arasında aranır (store.HighscoreStore.FirstSubmission).
+func Check(highscore models.Highscore, original *models.Highscore) (models.SuspiciousHighscore, bool) {
+	finding := models.SuspiciousHighscore{Highscore: highscore, Reasons: []string{}, Detail: []string{}}
+	add := func(reason, detail string) {
+		finding.Reasons = append(finding.Reasons, reason)
+		finding.Detail = append(finding.Detail, detail)
+	}
+
+	switch {
+	case highscore.FinalBlind < 1 || highscore.Score < 0:
+		add(models.SuspicionImpossibleScore, fmt.Sprintf("geçersiz skor %d veya blind %d", highscore.Score, highscore.FinalBlind))
+	case highscore.Score < MinimumScore(highscore.FinalBlind):
+		add(models.SuspicionImpossibleScore, fmt.Sprintf("blind %d için en az %d skor gerekir, skor %d", highscore.FinalBlind, MinimumScore(highscore.FinalBlind), highscore.Score))
+	case highscore.Score > MaximumPlausibleScore(highscore.FinalBlind):
+		add(models.SuspicionImpossibleScore, fmt.Sprintf("blind %d için skor %d beklenenin çok üstünde", highscore.FinalBlind, highscore.Score))
+	}
+
+	if len(highscore.JokersUsed) > run.MaxJokers {
+		add(models.SuspicionTooManyJokers, fmt.Sprintf("%d joker kullanılmış, en fazla %d", len(highscore.JokersUsed), run.MaxJokers))
+	}
+	for _, id := range highscore.JokersUsed {
+		if !jokers.IsKnown(id) {
+			add(models.SuspicionUnknownJoker, "joker ID tanımlı değil: "+id)
+		}
+	}
+
+	if original != nil {
+		add(models.SuspicionDuplicate, "aynı run daha önce gönderilmiş: "+original.ID.Hex())
+	}
+
+	return finding, len(finding.Reasons) > 0
+}
@@ -0,0 +1,618 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"balatro-backend/models"
+
+	bolt "go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Bolt bucket'ları: her koleksiyon ayrı bir bucket'ta, kayıtlar JSON olarak tutulur
+const (
+	bucketPlayerStates       = "playerStates"       // Anahtar: kullanıcı ID'si
+	bucketHighscores         = "highscores"         // Anahtar: skor ID'si
+	bucketRejectedHighscores = "rejectedHighscores" // Anahtar: kayıt ID'si
+	bucketRuns               = "runs"               // Anahtar: run ID'si
+	bucketProfiles           = "profiles"           // Anahtar: kullanıcı ID'si
+	bucketUsers              = "users"              // Anahtar: kullanıcı ID'si
+	bucketLeaderboardBans    = "leaderboardBans"    // Anahtar: kullanıcı ID'si
+	bucketAuditLog           = "auditLog"           // Anahtar: kayıt ID'si
+)
+
+var fileBuckets = []string{
+	bucketPlayerStates, bucketHighscores, bucketRejectedHighscores, bucketRuns,
+	bucketProfiles, bucketUsers, bucketLeaderboardBans, bucketAuditLog,
+}
+
+// fileKey Bolt dosyasındaki tek bir kayıt
+type fileKey struct {
+	bucket string
+	id     string
+}
+
+// fileUser kullanıcı kaydı; models.User şifre ve cihaz token'ı özetlerini
+// JSON'a yazmadığı için dosyada ayrı tutulur
+type fileUser struct {
+	models.User
+	PasswordHash    string `json:"passwordHash,omitempty"`
+	DeviceTokenHash string `json:"deviceTokenHash,omitempty"`
+}
+
+// fileDB bellek içi depoları tek bir Bolt dosyasına yansıtır. Okumalar ve
+// sorgular bellekten yapılır; her yazma yalnızca değişen kayıtları tek bir
+// Bolt transaction'ında diske yazar. Transaction başarısız olursa bellekteki
+// değişiklik geri alınır, böylece bellek ve disk ayrışmaz.
+type fileDB struct {
+	mu         sync.Mutex
+	bolt       *bolt.DB
+	lastErr    error
+	states     *MemoryPlayerStateStore
+	highscores *MemoryHighscoreStore
+	runs       *MemoryRunStore
+	profiles   *MemoryProfileStore
+	users      *MemoryUserStore
+	moderation *MemoryModerationStore
+}
+
+// NewFileStores verilen Bolt dosyasında saklanan depoları oluşturur; dosya
+// yoksa oluşturulur. Küçük kurulumlar (tek sunucu, LAN) içindir; dosyayı aynı
+// anda tek süreç açabilir.
+func NewFileStores(path string) (Stores, error) {
+	handle, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return Stores{}, fmt.Errorf("veri dosyası açılamadı: %w", err)
+	}
+	db := &fileDB{
+		bolt:       handle,
+		states:     NewMemoryPlayerStateStore(),
+		highscores: NewMemoryHighscoreStore(),
+		runs:       NewMemoryRunStore(),
+		profiles:   NewMemoryProfileStore(),
+		users:      NewMemoryUserStore(),
+		moderation: NewMemoryModerationStore(),
+	}
+	if err := db.load(); err != nil {
+		handle.Close()
+		return Stores{}, err
+	}
+
+	accounts := &memoryAccounts{
+		users:      db.users,
+		states:     db.states,
+		highscores: db.highscores,
+		runs:       db.runs,
+		profiles:   db.profiles,
+		moderation: db.moderation,
+	}
+	return Stores{
+		PlayerStates: &filePlayerStates{MemoryPlayerStateStore: db.states, db: db},
+		Highscores:   &fileHighscores{MemoryHighscoreStore: db.highscores, db: db},
+		Runs:         &fileRuns{MemoryRunStore: db.runs, db: db},
+		Profiles:     &fileProfiles{MemoryProfileStore: db.profiles, db: db},
+		Users:        &fileUsers{MemoryUserStore: db.users, db: db},
+		Accounts:     &fileAccounts{memoryAccounts: accounts, db: db},
+		Moderation:   &fileModeration{MemoryModerationStore: db.moderation, db: db},
+		Health:       db,
+	}, nil
+}
+
+// load bucket'ları oluşturur ve tüm kayıtları bellek içi depolara yükler
+func (db *fileDB) load() error {
+	err := db.bolt.Update(func(tx *bolt.Tx) error {
+		for _, name := range fileBuckets {
+			bucket, err := tx.CreateBucketIfNotExists([]byte(name))
+			if err != nil {
+				return err
+			}
+			err = bucket.ForEach(func(k, v []byte) error {
+				if err := db.set(fileKey{name, string(k)}, v); err != nil {
+					return fmt.Errorf("veri dosyası bozuk (%s/%s): %w", name, k, err)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("veri dosyası okunamadı: %w", err)
+	}
+
+	// Audit log eklenme sırasıyla tutulur
+	sort.SliceStable(db.moderation.audit, func(i, j int) bool {
+		return db.moderation.audit[i].CreatedAt.Before(db.moderation.audit[j].CreatedAt)
+	})
+	return nil
+}
+
+// get kaydın bellekteki güncel halini JSON olarak döndürür; kayıt yoksa nil
+func (db *fileDB) get(key fileKey) ([]byte, error) {
+	switch key.bucket {
+	case bucketPlayerStates:
+		db.states.mu.RLock()
+		defer db.states.mu.RUnlock()
+		if state, ok := db.states.states[key.id]; ok {
+			return json.Marshal(state)
+		}
+	case bucketHighscores:
+		db.highscores.mu.RLock()
+		defer db.highscores.mu.RUnlock()
+		for i := range db.highscores.highscores {
+			if db.highscores.highscores[i].ID.Hex() == key.id {
+				return json.Marshal(db.highscores.highscores[i])
+			}
+		}
+	case bucketRejectedHighscores:
+		db.highscores.mu.RLock()
+		defer db.highscores.mu.RUnlock()
+		for i := range db.highscores.rejected {
+			if db.highscores.rejected[i].ID.Hex() == key.id {
+				return json.Marshal(db.highscores.rejected[i])
+			}
+		}
+	case bucketRuns:
+		db.runs.mu.RLock()
+		defer db.runs.mu.RUnlock()
+		if id, err := primitive.ObjectIDFromHex(key.id); err == nil {
+			if run, ok := db.runs.runs[id]; ok {
+				return json.Marshal(run)
+			}
+		}
+	case bucketProfiles:
+		db.profiles.mu.RLock()
+		defer db.profiles.mu.RUnlock()
+		if profile, ok := db.profiles.profiles[key.id]; ok {
+			return json.Marshal(profile)
+		}
+	case bucketUsers:
+		db.users.mu.RLock()
+		defer db.users.mu.RUnlock()
+		if id, err := primitive.ObjectIDFromHex(key.id); err == nil {
+			if user, ok := db.users.users[id]; ok {
+				return json.Marshal(fileUser{User: *user, PasswordHash: user.PasswordHash, DeviceTokenHash: user.DeviceTokenHash})
+			}
+		}
+	case bucketLeaderboardBans:
+		db.moderation.mu.RLock()
+		defer db.moderation.mu.RUnlock()
+		if ban, ok := db.moderation.bans[key.id]; ok {
+			return json.Marshal(ban)
+		}
+	case bucketAuditLog:
+		db.moderation.mu.RLock()
+		defer db.moderation.mu.RUnlock()
+		for i := range db.moderation.audit {
+			if db.moderation.audit[i].ID.Hex() == key.id {
+				return json.Marshal(db.moderation.audit[i])
+			}
+		}
+	default:
+		return nil, fmt.Errorf("bilinmeyen bucket: %s", key.bucket)
+	}
+	return nil, nil
+}
+
+// set kaydı bellekte JSON verisiyle değiştirir; data nil ise kaydı siler
+func (db *fileDB) set(key fileKey, data []byte) error {
+	switch key.bucket {
+	case bucketPlayerStates:
+		db.states.mu.Lock()
+		defer db.states.mu.Unlock()
+		if data == nil {
+			delete(db.states.states, key.id)
+			return nil
+		}
+		var state models.PlayerState
+		if err := json.Unmarshal(data, &state); err != nil {
+			return err
+		}
+		db.states.states[key.id] = &state
+	case bucketHighscores:
+		db.highscores.mu.Lock()
+		defer db.highscores.mu.Unlock()
+		var highscore models.Highscore
+		if data != nil {
+			if err := json.Unmarshal(data, &highscore); err != nil {
+				return err
+			}
+		}
+		db.highscores.highscores = setByID(db.highscores.highscores, key.id, data != nil, highscore,
+			func(h models.Highscore) string { return h.ID.Hex() })
+	case bucketRejectedHighscores:
+		db.highscores.mu.Lock()
+		defer db.highscores.mu.Unlock()
+		var rejected models.RejectedHighscore
+		if data != nil {
+			if err := json.Unmarshal(data, &rejected); err != nil {
+				return err
+			}
+		}
+		db.highscores.rejected = setByID(db.highscores.rejected, key.id, data != nil, rejected,
+			func(r models.RejectedHighscore) string { return r.ID.Hex() })
+	case bucketRuns:
+		id, err := primitive.ObjectIDFromHex(key.id)
+		if err != nil {
+			return err
+		}
+		db.runs.mu.Lock()
+		defer db.runs.mu.Unlock()
+		if data == nil {
+			delete(db.runs.runs, id)
+			return nil
+		}
+		var run models.Run
+		if err := json.Unmarshal(data, &run); err != nil {
+			return err
+		}
+		db.runs.runs[id] = &run
+	case bucketProfiles:
+		db.profiles.mu.Lock()
+		defer db.profiles.mu.Unlock()
+		if data == nil {
+			delete(db.profiles.profiles, key.id)
+			return nil
+		}
+		var profile models.Profile
+		if err := json.Unmarshal(data, &profile); err != nil {
+			return err
+		}
+		db.profiles.profiles[key.id] = &profile
+	case bucketUsers:
+		id, err := primitive.ObjectIDFromHex(key.id)
+		if err != nil {
+			return err
+		}
+		db.users.mu.Lock()
+		defer db.users.mu.Unlock()
+		if data == nil {
+			delete(db.users.users, id)
+			return nil
+		}
+		var stored fileUser
+		if err := json.Unmarshal(data, &stored); err != nil {
+			return err
+		}
+		user := stored.User
+		user.PasswordHash = stored.PasswordHash
+		user.DeviceTokenHash = stored.DeviceTokenHash
+		db.users.users[id] = &user
+	case bucketLeaderboardBans:
+		db.moderation.mu.Lock()
+		defer db.moderation.mu.Unlock()
+		if data == nil {
+			delete(db.moderation.bans, key.id)
+			return nil
+		}
+		var ban models.LeaderboardBan
+		if err := json.Unmarshal(data, &ban); err != nil {
+			return err
+		}
+		db.moderation.bans[key.id] = &ban
+	case bucketAuditLog:
+		db.moderation.mu.Lock()
+		defer db.moderation.mu.Unlock()
+		var entry models.AuditLogEntry
+		if data != nil {
+			if err := json.Unmarshal(data, &entry); err != nil {
+				return err
+			}
+		}
+		db.moderation.audit = setByID(db.moderation.audit, key.id, data != nil, entry,
+			func(e models.AuditLogEntry) string { return e.ID.Hex() })
+	default:
+		return fmt.Errorf("bilinmeyen bucket: %s", key.bucket)
+	}
+	return nil
+}
+
+// setByID listedeki kaydı ID ile değiştirir, yoksa sona ekler; present false
+// ise kaydı listeden çıkarır
+func setByID[T any](list []T, id string, present bool, value T, idOf func(T) string) []T {
+	for i := range list {
+		if idOf(list[i]) == id {
+			if present {
+				list[i] = value
+				return list
+			}
+			return append(list[:i], list[i+1:]...)
+		}
+	}
+	if present {
+		list = append(list, value)
+	}
+	return list
+}
+
+// persist kayıtların bellekteki güncel hallerini tek transaction'da dosyaya yazar
+func (db *fileDB) persist(keys []fileKey) error {
+	return db.bolt.Update(func(tx *bolt.Tx) error {
+		for _, key := range keys {
+			data, err := db.get(key)
+			if err != nil {
+				return err
+			}
+			bucket := tx.Bucket([]byte(key.bucket))
+			if data == nil {
+				err = bucket.Delete([]byte(key.id))
+			} else {
+				err = bucket.Put([]byte(key.id), data)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// write değişikliği bellekte uygular ve touched'ın döndürdüğü kayıtları dosyaya
+// yazar. touched değişiklikten önce ve sonra çağrılır (yeni kayıtların ID'si
+// değişiklikte atanır). Dosyaya yazılamazsa kayıtlar bellekte eski hallerine
+// döndürülür ve hata döner.
+func (db *fileDB) write(touched func() []fileKey, apply func() error) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var keys []fileKey
+	previous := map[fileKey][]byte{}
+	for _, key := range touched() {
+		if _, ok := previous[key]; ok {
+			continue
+		}
+		data, err := db.get(key)
+		if err != nil {
+			return err
+		}
+		previous[key] = data
+		keys = append(keys, key)
+	}
+
+	if err := apply(); err != nil {
+		return err
+	}
+	for _, key := range touched() {
+		if _, ok := previous[key]; !ok {
+			previous[key] = nil // Değişiklikten önce yoktu
+			keys = append(keys, key)
+		}
+	}
+
+	err := db.persist(keys)
+	if err != nil {
+		rollback := []error{fmt.Errorf("veri dosyası yazılamadı: %w", err)}
+		for _, key := range keys {
+			if err := db.set(key, previous[key]); err != nil {
+				rollback = append(rollback, fmt.Errorf("%s/%s geri alınamadı: %w", key.bucket, key.id, err))
+			}
+		}
+		err = errors.Join(rollback...)
+	}
+	db.lastErr = err
+	return err
+}
+
+// ownedKeys kullanıcıya ait tüm kayıtları döndürür (misafir yükseltmesi için)
+func (db *fileDB) ownedKeys(userID string) []fileKey {
+	keys := []fileKey{
+		{bucketPlayerStates, userID},
+		{bucketProfiles, userID},
+		{bucketLeaderboardBans, userID},
+		{bucketUsers, userID},
+	}
+
+	keys = append(keys, db.highscoreKeys(userID)...)
+
+	db.highscores.mu.RLock()
+	for _, rejected := range db.highscores.rejected {
+		if rejected.UserID == userID {
+			keys = append(keys, fileKey{bucketRejectedHighscores, rejected.ID.Hex()})
+		}
+	}
+	db.highscores.mu.RUnlock()
+
+	db.runs.mu.RLock()
+	for id, run := range db.runs.runs {
+		if run.UserID == userID {
+			keys = append(keys, fileKey{bucketRuns, id.Hex()})
+		}
+	}
+	db.runs.mu.RUnlock()
+	return keys
+}
+
+// highscoreKeys kullanıcının tüm skorlarının anahtarlarını döndürür
+func (db *fileDB) highscoreKeys(userID string) []fileKey {
+	db.highscores.mu.RLock()
+	defer db.highscores.mu.RUnlock()
+
+	var keys []fileKey
+	for _, highscore := range db.highscores.highscores {
+		if highscore.UserID == userID {
+			keys = append(keys, fileKey{bucketHighscores, highscore.ID.Hex()})
+		}
+	}
+	return keys
+}
+
+// Ping son yazma işleminin hatasını döndürür
+func (db *fileDB) Ping(ctx context.Context) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.lastErr
+}
+
+type filePlayerStates struct {
+	*MemoryPlayerStateStore
+	db *fileDB
+}
+
+func (s *filePlayerStates) Upsert(ctx context.Context, state *models.PlayerState) (UpsertResult, error) {
+	var result UpsertResult
+	err := s.db.write(func() []fileKey {
+		return []fileKey{{bucketPlayerStates, state.UserID}}
+	}, func() (err error) {
+		result, err = s.MemoryPlayerStateStore.Upsert(ctx, state)
+		return err
+	})
+	return result, err
+}
+
+func (s *filePlayerStates) Delete(ctx context.Context, userID string) error {
+	return s.db.write(func() []fileKey {
+		return []fileKey{{bucketPlayerStates, userID}}
+	}, func() error {
+		return s.MemoryPlayerStateStore.Delete(ctx, userID)
+	})
+}
+
+type fileHighscores struct {
+	*MemoryHighscoreStore
+	db *fileDB
+}
+
+func (s *fileHighscores) Insert(ctx context.Context, highscore *models.Highscore) error {
+	return s.db.write(func() []fileKey {
+		return []fileKey{{bucketHighscores, highscore.ID.Hex()}}
+	}, func() error {
+		return s.MemoryHighscoreStore.Insert(ctx, highscore)
+	})
+}
+
+func (s *fileHighscores) Moderate(ctx context.Context, id primitive.ObjectID, moderation HighscoreModeration) error {
+	return s.db.write(func() []fileKey {
+		return []fileKey{{bucketHighscores, id.Hex()}}
+	}, func() error {
+		return s.MemoryHighscoreStore.Moderate(ctx, id, moderation)
+	})
+}
+
+func (s *fileHighscores) SetBanned(ctx context.Context, userID string, banned bool) error {
+	return s.db.write(func() []fileKey {
+		return s.db.highscoreKeys(userID)
+	}, func() error {
+		return s.MemoryHighscoreStore.SetBanned(ctx, userID, banned)
+	})
+}
+
+func (s *fileHighscores) InsertRejected(ctx context.Context, rejected *models.RejectedHighscore) error {
+	return s.db.write(func() []fileKey {
+		return []fileKey{{bucketRejectedHighscores, rejected.ID.Hex()}}
+	}, func() error {
+		return s.MemoryHighscoreStore.InsertRejected(ctx, rejected)
+	})
+}
+
+type fileRuns struct {
+	*MemoryRunStore
+	db *fileDB
+}
+
+func (s *fileRuns) Create(ctx context.Context, run *models.Run) error {
+	return s.db.write(func() []fileKey {
+		return []fileKey{{bucketRuns, run.ID.Hex()}}
+	}, func() error {
+		return s.MemoryRunStore.Create(ctx, run)
+	})
+}
+
+func (s *fileRuns) Update(ctx context.Context, run *models.Run, expectedVersion int) error {
+	return s.db.write(func() []fileKey {
+		return []fileKey{{bucketRuns, run.ID.Hex()}}
+	}, func() error {
+		return s.MemoryRunStore.Update(ctx, run, expectedVersion)
+	})
+}
+
+type fileProfiles struct {
+	*MemoryProfileStore
+	db *fileDB
+}
+
+func (s *fileProfiles) Save(ctx context.Context, profile *models.Profile) error {
+	return s.db.write(func() []fileKey {
+		return []fileKey{{bucketProfiles, profile.UserID}}
+	}, func() error {
+		return s.MemoryProfileStore.Save(ctx, profile)
+	})
+}
+
+type fileUsers struct {
+	*MemoryUserStore
+	db *fileDB
+}
+
+func (s *fileUsers) Create(ctx context.Context, user *models.User) error {
+	return s.db.write(func() []fileKey {
+		return []fileKey{{bucketUsers, user.ID.Hex()}}
+	}, func() error {
+		return s.MemoryUserStore.Create(ctx, user)
+	})
+}
+
+func (s *fileUsers) SetRole(ctx context.Context, username, role string) error {
+	return s.db.write(func() []fileKey {
+		user, err := s.MemoryUserStore.GetByUsername(ctx, username)
+		if err != nil {
+			return nil
+		}
+		return []fileKey{{bucketUsers, user.ID.Hex()}}
+	}, func() error {
+		return s.MemoryUserStore.SetRole(ctx, username, role)
+	})
+}
+
+type fileAccounts struct {
+	*memoryAccounts
+	db *fileDB
+}
+
+func (a *fileAccounts) UpgradeGuest(ctx context.Context, guestID string, user *models.User) error {
+	return a.db.write(func() []fileKey {
+		keys := a.db.ownedKeys(guestID)
+		if !user.ID.IsZero() {
+			keys = append(keys, a.db.ownedKeys(user.ID.Hex())...)
+		}
+		return keys
+	}, func() error {
+		return a.memoryAccounts.UpgradeGuest(ctx, guestID, user)
+	})
+}
+
+type fileModeration struct {
+	*MemoryModerationStore
+	db *fileDB
+}
+
+func (s *fileModeration) Ban(ctx context.Context, ban *models.LeaderboardBan) error {
+	return s.db.write(func() []fileKey {
+		return []fileKey{{bucketLeaderboardBans, ban.UserID}}
+	}, func() error {
+		return s.MemoryModerationStore.Ban(ctx, ban)
+	})
+}
+
+func (s *fileModeration) Unban(ctx context.Context, userID string) error {
+	return s.db.write(func() []fileKey {
+		return []fileKey{{bucketLeaderboardBans, userID}}
+	}, func() error {
+		return s.MemoryModerationStore.Unban(ctx, userID)
+	})
+}
+
+func (s *fileModeration) AppendAudit(ctx context.Context, entry *models.AuditLogEntry) error {
+	return s.db.write(func() []fileKey {
+		return []fileKey{{bucketAuditLog, entry.ID.Hex()}}
+	}, func() error {
+		return s.MemoryModerationStore.AppendAudit(ctx, entry)
+	})
+}
@@ -0,0 +1,93 @@
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"balatro-backend/models"
+)
+
+// openFile test için dosya deposunu açar; test bitince dosya kapatılır
+func openFile(t *testing.T, path string) Stores {
+	t.Helper()
+	stores, err := NewFileStores(path)
+	if err != nil {
+		t.Fatalf("NewFileStores: %v", err)
+	}
+	t.Cleanup(func() { stores.Health.(*fileDB).bolt.Close() })
+	return stores
+}
+
+// closeFile dosyayı kapatır (yeniden açma veya yazma hatası için)
+func closeFile(t *testing.T, stores Stores) {
+	t.Helper()
+	if err := stores.Health.(*fileDB).bolt.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+}
+
+func TestFileStoresPersistAcrossReopen(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "balatro.db")
+
+	stores := openFile(t, path)
+	user := &models.User{Username: "ada", PasswordHash: "hash"}
+	if err := stores.Users.Create(ctx, user); err != nil {
+		t.Fatalf("Create user: %v", err)
+	}
+	if _, err := stores.PlayerStates.Upsert(ctx, &models.PlayerState{UserID: user.ID.Hex(), Money: 42}); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+	for _, score := range []int64{300, 900, 600} {
+		if err := stores.Highscores.Insert(ctx, &models.Highscore{UserID: user.ID.Hex(), Score: score}); err != nil {
+			t.Fatalf("Insert: %v", err)
+		}
+	}
+	closeFile(t, stores)
+
+	reopened := openFile(t, path)
+	loaded, err := reopened.Users.GetByUsername(ctx, "ada")
+	if err != nil || loaded.PasswordHash != "hash" {
+		t.Fatalf("user = %+v, %v; want password hash kept", loaded, err)
+	}
+	state, err := reopened.PlayerStates.Get(ctx, user.ID.Hex())
+	if err != nil || state.Money != 42 {
+		t.Fatalf("state = %+v, %v; want money 42", state, err)
+	}
+	top, total, err := reopened.Highscores.List(ctx, HighscoreFilter{}, 10, 0)
+	if err != nil || total != 3 || top[0].Score != 900 {
+		t.Fatalf("list = %+v (%d), %v; want 3 scores led by 900", top, total, err)
+	}
+	if above, _ := reopened.Highscores.CountAbove(ctx, HighscoreFilter{}, 600); above != 1 {
+		t.Errorf("CountAbove(600) = %d, want 1", above)
+	}
+}
+
+func TestFileStoresRollBackFailedWrite(t *testing.T) {
+	ctx := context.Background()
+	stores := openFile(t, filepath.Join(t.TempDir(), "balatro.db"))
+
+	if _, err := stores.PlayerStates.Upsert(ctx, &models.PlayerState{UserID: "u1", Money: 10}); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+	closeFile(t, stores)
+
+	if _, err := stores.PlayerStates.Upsert(ctx, &models.PlayerState{UserID: "u1", Money: 99}); err == nil {
+		t.Fatal("write to a closed file succeeded")
+	}
+	if err := stores.Highscores.Insert(ctx, &models.Highscore{UserID: "u1", Score: 5}); err == nil {
+		t.Fatal("write to a closed file succeeded")
+	}
+	if err := stores.Health.Ping(ctx); err == nil {
+		t.Error("Ping did not report the failed write")
+	}
+
+	state, err := stores.PlayerStates.Get(ctx, "u1")
+	if err != nil || state.Money != 10 {
+		t.Errorf("state = %+v, %v; want the unwritten change rolled back", state, err)
+	}
+	if _, total, _ := stores.Highscores.List(ctx, HighscoreFilter{}, 0, 0); total != 0 {
+		t.Errorf("highscores = %d, want the unwritten insert rolled back", total)
+	}
+}
@@ -0,0 +1,688 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+
+	"balatro-backend/migrations"
+	"balatro-backend/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// NewMemoryStores süreç belleğinde çalışan depoları oluşturur. Veriler
+// yeniden başlatmada kaybolur; testler ve veritabanısız geliştirme içindir.
+func NewMemoryStores() Stores {
+	accounts := &memoryAccounts{
+		users:      NewMemoryUserStore(),
+		states:     NewMemoryPlayerStateStore(),
+		highscores: NewMemoryHighscoreStore(),
+		runs:       NewMemoryRunStore(),
+		profiles:   NewMemoryProfileStore(),
+		moderation: NewMemoryModerationStore(),
+	}
+	return Stores{
+		PlayerStates: accounts.states,
+		Highscores:   accounts.highscores,
+		Runs:         accounts.runs,
+		Profiles:     accounts.profiles,
+		Users:        accounts.users,
+		Accounts:     accounts,
+		Moderation:   accounts.moderation,
+		Health:       memoryPinger{},
+	}
+}
+
+// clone kaydı BSON üzerinden derin kopyalar; böylece bellekteki kayıtlar
+// MongoDB'deki gibi çağıranın değişikliklerinden etkilenmez
+func clone(src, dst interface{}) error {
+	data, err := bson.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return bson.Unmarshal(data, dst)
+}
+
+// MemoryPlayerStateStore bellekte çalışan PlayerStateStore
+type MemoryPlayerStateStore struct {
+	mu     sync.RWMutex
+	states map[string]*models.PlayerState
+}
+
+// NewMemoryPlayerStateStore boş bir bellek içi oyun durumu deposu oluşturur
+func NewMemoryPlayerStateStore() *MemoryPlayerStateStore {
+	return &MemoryPlayerStateStore{states: map[string]*models.PlayerState{}}
+}
+
+// Get kullanıcının oyun durumunu döndürür
+func (s *MemoryPlayerStateStore) Get(ctx context.Context, userID string) (*models.PlayerState, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stored, ok := s.states[userID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	var state models.PlayerState
+	if err := clone(stored, &state); err != nil {
+		return nil, err
+	}
+	migrations.UpgradePlayerState(&state)
+	return &state, nil
+}
+
+// Upsert oyun durumunu oluşturur veya günceller
+func (s *MemoryPlayerStateStore) Upsert(ctx context.Context, state *models.PlayerState) (UpsertResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var stored models.PlayerState
+	if err := clone(state, &stored); err != nil {
+		return UpsertResult{}, err
+	}
+
+	existing, ok := s.states[state.UserID]
+	if ok {
+		stored.ID = existing.ID
+		s.states[state.UserID] = &stored
+		return UpsertResult{Matched: true, Modified: true}, nil
+	}
+	stored.ID = primitive.NewObjectID()
+	s.states[state.UserID] = &stored
+	return UpsertResult{UpsertedID: stored.ID}, nil
+}
+
+// Delete kullanıcının oyun durumunu siler
+func (s *MemoryPlayerStateStore) Delete(ctx context.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.states[userID]; !ok {
+		return ErrNotFound
+	}
+	delete(s.states, userID)
+	return nil
+}
+
+// MemoryHighscoreStore bellekte çalışan HighscoreStore
+type MemoryHighscoreStore struct {
+	mu         sync.RWMutex
+	highscores []models.Highscore
+	rejected   []models.RejectedHighscore
+}
+
+// NewMemoryHighscoreStore boş bir bellek içi yüksek skor deposu oluşturur
+func NewMemoryHighscoreStore() *MemoryHighscoreStore {
+	return &MemoryHighscoreStore{}
+}
+
+// matches skorun filtreye uyup uymadığını kontrol eder
+func (f HighscoreFilter) matches(highscore models.Highscore) bool {
+	if f.VisibleOnly && (highscore.Flagged || highscore.DeletedAt != nil || highscore.Banned) {
+		return false
+	}
+	return (f.UserID == "" || highscore.UserID == f.UserID) &&
+		(f.Deck == "" || highscore.Deck == f.Deck) &&
+		(f.Stake == "" || highscore.Stake == f.Stake)
+}
+
+// sortHighscores skorları skor, ardından tarih azalan sırayla sıralar
+func sortHighscores(list []models.Highscore) {
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].Score != list[j].Score {
+			return list[i].Score > list[j].Score
+		}
+		return list[i].DateAchieved.After(list[j].DateAchieved)
+	})
+}
+
+// Insert yüksek skoru kaydeder
+func (s *MemoryHighscoreStore) Insert(ctx context.Context, highscore *models.Highscore) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	highscore.ID = primitive.NewObjectID()
+	var stored models.Highscore
+	if err := clone(highscore, &stored); err != nil {
+		return err
+	}
+	s.highscores = append(s.highscores, stored)
+	return nil
+}
+
+// List filtreye uyan skorları sıralı ve sayfalanmış olarak döndürür
+func (s *MemoryHighscoreStore) List(ctx context.Context, filter HighscoreFilter, limit, offset int) ([]models.Highscore, int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matched := []models.Highscore{}
+	for _, highscore := range s.highscores {
+		if filter.matches(highscore) {
+			matched = append(matched, highscore)
+		}
+	}
+	sortHighscores(matched)
+
+	total := int64(len(matched))
+	if offset > len(matched) {
+		offset = len(matched)
+	}
+	end := offset + limit
+	if limit <= 0 || end > len(matched) {
+		end = len(matched)
+	}
+
+	page := make([]models.Highscore, 0, end-offset)
+	for _, highscore := range matched[offset:end] {
+		var copied models.Highscore
+		if err := clone(highscore, &copied); err != nil {
+			return nil, 0, err
+		}
+		page = append(page, copied)
+	}
+	return page, total, nil
+}
+
+// Best kullanıcının en yüksek skorunu döndürür
+func (s *MemoryHighscoreStore) Best(ctx context.Context, userID string) (*models.Highscore, error) {
+	page, _, err := s.List(ctx, HighscoreFilter{UserID: userID, VisibleOnly: true}, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(page) == 0 {
+		return nil, ErrNotFound
+	}
+	return &page[0], nil
+}
+
+// CountAbove filtreye uyan skorlardan verilen skordan yüksek olanları sayar
+func (s *MemoryHighscoreStore) CountAbove(ctx context.Context, filter HighscoreFilter, score int64) (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var count int64
+	for _, highscore := range s.highscores {
+		if highscore.Score > score && filter.matches(highscore) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// Get ID ile skoru döndürür
+func (s *MemoryHighscoreStore) Get(ctx context.Context, id primitive.ObjectID) (*models.Highscore, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, stored := range s.highscores {
+		if stored.ID == id {
+			var highscore models.Highscore
+			if err := clone(stored, &highscore); err != nil {
+				return nil, err
+			}
+			return &highscore, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// Moderate skorun moderasyon alanlarını değiştirir
+func (s *MemoryHighscoreStore) Moderate(ctx context.Context, id primitive.ObjectID, moderation HighscoreModeration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.highscores {
+		if s.highscores[i].ID == id {
+			s.highscores[i].Flagged = moderation.Flagged
+			s.highscores[i].FlagReason = moderation.FlagReason
+			s.highscores[i].DeletedAt = moderation.DeletedAt
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+// sameRun iki skorun aynı run'ın gönderimleri olup olmadığını kontrol eder
+func sameRun(a, b models.Highscore) bool {
+	if a.Score != b.Score || a.FinalBlind != b.FinalBlind || a.Seed != b.Seed {
+		return false
+	}
+	if a.Seed == "" {
+		return a.UserID == b.UserID
+	}
+	return a.Deck == b.Deck && a.Stake == b.Stake
+}
+
+// submittedBefore a skorunun b'den önce kaydedilip kaydedilmediğini kontrol
+// eder; tarihler eşitse ID sırasına bakılır
+func submittedBefore(a, b models.Highscore) bool {
+	if !a.DateAchieved.Equal(b.DateAchieved) {
+		return a.DateAchieved.Before(b.DateAchieved)
+	}
+	return bytes.Compare(a.ID[:], b.ID[:]) < 0
+}
+
+// FirstSubmission aynı run'ın bu skordan önceki ilk gönderimini döndürür
+func (s *MemoryHighscoreStore) FirstSubmission(ctx context.Context, highscore models.Highscore) (*models.Highscore, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var first *models.Highscore
+	for i := range s.highscores {
+		stored := &s.highscores[i]
+		if sameRun(*stored, highscore) && submittedBefore(*stored, highscore) &&
+			(first == nil || submittedBefore(*stored, *first)) {
+			first = stored
+		}
+	}
+	if first == nil {
+		return nil, ErrNotFound
+	}
+	var copied models.Highscore
+	if err := clone(first, &copied); err != nil {
+		return nil, err
+	}
+	return &copied, nil
+}
+
+// SetBanned kullanıcının tüm skorlarının yasak işaretini değiştirir
+func (s *MemoryHighscoreStore) SetBanned(ctx context.Context, userID string, banned bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.highscores {
+		if s.highscores[i].UserID == userID {
+			s.highscores[i].Banned = banned
+		}
+	}
+	return nil
+}
+
+// InsertRejected reddedilen gönderimi kaydeder
+func (s *MemoryHighscoreStore) InsertRejected(ctx context.Context, rejected *models.RejectedHighscore) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rejected.ID = primitive.NewObjectID()
+	var stored models.RejectedHighscore
+	if err := clone(rejected, &stored); err != nil {
+		return err
+	}
+	s.rejected = append(s.rejected, stored)
+	return nil
+}
+
+// MemoryRunStore bellekte çalışan RunStore
+type MemoryRunStore struct {
+	mu   sync.RWMutex
+	runs map[primitive.ObjectID]*models.Run
+}
+
+// NewMemoryRunStore boş bir bellek içi run deposu oluşturur
+func NewMemoryRunStore() *MemoryRunStore {
+	return &MemoryRunStore{runs: map[primitive.ObjectID]*models.Run{}}
+}
+
+// Create yeni run'ı kaydeder
+func (s *MemoryRunStore) Create(ctx context.Context, run *models.Run) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run.ID = primitive.NewObjectID()
+	var stored models.Run
+	if err := clone(run, &stored); err != nil {
+		return err
+	}
+	s.runs[run.ID] = &stored
+	return nil
+}
+
+// Get ID ile run'ı döndürür
+func (s *MemoryRunStore) Get(ctx context.Context, id primitive.ObjectID) (*models.Run, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stored, ok := s.runs[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	var run models.Run
+	if err := clone(stored, &run); err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// Update run'ı sürüm kontrolüyle değiştirir
+func (s *MemoryRunStore) Update(ctx context.Context, run *models.Run, expectedVersion int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.runs[run.ID]
+	if !ok || stored.Version != expectedVersion {
+		return ErrVersionConflict
+	}
+	var updated models.Run
+	if err := clone(run, &updated); err != nil {
+		return err
+	}
+	s.runs[run.ID] = &updated
+	return nil
+}
+
+// MemoryProfileStore bellekte çalışan ProfileStore
+type MemoryProfileStore struct {
+	mu       sync.RWMutex
+	profiles map[string]*models.Profile
+}
+
+// NewMemoryProfileStore boş bir bellek içi profil deposu oluşturur
+func NewMemoryProfileStore() *MemoryProfileStore {
+	return &MemoryProfileStore{profiles: map[string]*models.Profile{}}
+}
+
+// Get kullanıcının profilini döndürür
+func (s *MemoryProfileStore) Get(ctx context.Context, userID string) (*models.Profile, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stored, ok := s.profiles[userID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	var profile models.Profile
+	if err := clone(stored, &profile); err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// Save profili oluşturur veya değiştirir
+func (s *MemoryProfileStore) Save(ctx context.Context, profile *models.Profile) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var stored models.Profile
+	if err := clone(profile, &stored); err != nil {
+		return err
+	}
+	if existing, ok := s.profiles[profile.UserID]; ok {
+		stored.ID = existing.ID
+	} else if stored.ID.IsZero() {
+		stored.ID = primitive.NewObjectID()
+	}
+	s.profiles[profile.UserID] = &stored
+	return nil
+}
+
+// MemoryUserStore bellekte çalışan UserStore
+type MemoryUserStore struct {
+	mu    sync.RWMutex
+	users map[primitive.ObjectID]*models.User
+}
+
+// NewMemoryUserStore boş bir bellek içi kullanıcı deposu oluşturur
+func NewMemoryUserStore() *MemoryUserStore {
+	return &MemoryUserStore{users: map[primitive.ObjectID]*models.User{}}
+}
+
+// taken kullanıcı adının veya cihaz token'ının kullanılıp kullanılmadığını
+// kontrol eder; çağıran kilidi tutmalıdır
+func (s *MemoryUserStore) taken(user *models.User) bool {
+	for _, stored := range s.users {
+		if user.Username != "" && stored.Username == user.Username {
+			return true
+		}
+		if user.DeviceTokenHash != "" && stored.DeviceTokenHash == user.DeviceTokenHash {
+			return true
+		}
+	}
+	return false
+}
+
+// insert kullanıcıya ID atayıp kopyasını saklar; çağıran kilidi tutmalıdır
+func (s *MemoryUserStore) insert(user *models.User) error {
+	if s.taken(user) {
+		return ErrDuplicate
+	}
+	user.ID = primitive.NewObjectID()
+	var stored models.User
+	if err := clone(user, &stored); err != nil {
+		return err
+	}
+	s.users[user.ID] = &stored
+	return nil
+}
+
+// find koşula uyan ilk kullanıcının kopyasını döndürür
+func (s *MemoryUserStore) find(match func(*models.User) bool) (*models.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, stored := range s.users {
+		if match(stored) {
+			var user models.User
+			if err := clone(stored, &user); err != nil {
+				return nil, err
+			}
+			return &user, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// Create yeni kullanıcıyı kaydeder
+func (s *MemoryUserStore) Create(ctx context.Context, user *models.User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.insert(user)
+}
+
+// GetByID ID ile kullanıcıyı döndürür
+func (s *MemoryUserStore) GetByID(ctx context.Context, id string) (*models.User, error) {
+	return s.find(func(user *models.User) bool { return user.ID.Hex() == id })
+}
+
+// GetByUsername kullanıcı adıyla kullanıcıyı döndürür
+func (s *MemoryUserStore) GetByUsername(ctx context.Context, username string) (*models.User, error) {
+	return s.find(func(user *models.User) bool { return user.Username == username })
+}
+
+// GetByDeviceToken cihaz token'ı özetiyle kullanıcıyı döndürür
+func (s *MemoryUserStore) GetByDeviceToken(ctx context.Context, tokenHash string) (*models.User, error) {
+	return s.find(func(user *models.User) bool { return user.DeviceTokenHash == tokenHash })
+}
+
+// SetRole kullanıcının rolünü değiştirir
+func (s *MemoryUserStore) SetRole(ctx context.Context, username, role string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, stored := range s.users {
+		if stored.Username == username {
+			stored.Role = role
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+// memoryAccounts bellek içi depolar üzerinde çalışan AccountStore
+type memoryAccounts struct {
+	users      *MemoryUserStore
+	states     *MemoryPlayerStateStore
+	highscores *MemoryHighscoreStore
+	runs       *MemoryRunStore
+	profiles   *MemoryProfileStore
+	moderation *MemoryModerationStore
+}
+
+// UpgradeGuest tüm depoları kilitleyip misafirin kayıtlarını yeni kullanıcıya taşır.
+// Değişiklikler doğrulamalardan sonra uygulanır; böylece yarıda kalmaz.
+func (a *memoryAccounts) UpgradeGuest(ctx context.Context, guestID string, user *models.User) error {
+	// Kilitler her zaman aynı sırayla alınır
+	a.users.mu.Lock()
+	defer a.users.mu.Unlock()
+	a.states.mu.Lock()
+	defer a.states.mu.Unlock()
+	a.highscores.mu.Lock()
+	defer a.highscores.mu.Unlock()
+	a.runs.mu.Lock()
+	defer a.runs.mu.Unlock()
+	a.profiles.mu.Lock()
+	defer a.profiles.mu.Unlock()
+	a.moderation.mu.Lock()
+	defer a.moderation.mu.Unlock()
+
+	guestObjectID, err := primitive.ObjectIDFromHex(guestID)
+	if err != nil {
+		return ErrNotFound
+	}
+	if guest, ok := a.users.users[guestObjectID]; !ok || !guest.Guest {
+		return ErrNotFound
+	}
+	if err := a.users.insert(user); err != nil {
+		return err
+	}
+	delete(a.users.users, guestObjectID)
+	userID := user.ID.Hex()
+
+	if state, ok := a.states.states[guestID]; ok {
+		state.UserID = userID
+		a.states.states[userID] = state
+		delete(a.states.states, guestID)
+	}
+	for i := range a.highscores.highscores {
+		if a.highscores.highscores[i].UserID == guestID {
+			a.highscores.highscores[i].UserID = userID
+		}
+	}
+	for i := range a.highscores.rejected {
+		if a.highscores.rejected[i].UserID == guestID {
+			a.highscores.rejected[i].UserID = userID
+		}
+	}
+	for _, run := range a.runs.runs {
+		if run.UserID == guestID {
+			run.UserID = userID
+			run.State.UserID = userID
+		}
+	}
+	if profile, ok := a.profiles.profiles[guestID]; ok {
+		profile.UserID = userID
+		a.profiles.profiles[userID] = profile
+		delete(a.profiles.profiles, guestID)
+	}
+	// Yasak yeni hesaba geçer; yükseltme yasaktan kaçmak için kullanılamaz
+	if ban, ok := a.moderation.bans[guestID]; ok {
+		ban.UserID = userID
+		a.moderation.bans[userID] = ban
+		delete(a.moderation.bans, guestID)
+	}
+	return nil
+}
+
+// MemoryModerationStore bellekte çalışan ModerationStore
+type MemoryModerationStore struct {
+	mu    sync.RWMutex
+	bans  map[string]*models.LeaderboardBan
+	audit []models.AuditLogEntry
+}
+
+// NewMemoryModerationStore boş bir bellek içi moderasyon deposu oluşturur
+func NewMemoryModerationStore() *MemoryModerationStore {
+	return &MemoryModerationStore{bans: map[string]*models.LeaderboardBan{}}
+}
+
+// Ban kullanıcıyı yasaklar veya yasak kaydını değiştirir
+func (s *MemoryModerationStore) Ban(ctx context.Context, ban *models.LeaderboardBan) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.bans[ban.UserID]; ok {
+		ban.ID = existing.ID
+	} else {
+		ban.ID = primitive.NewObjectID()
+	}
+	stored := *ban
+	s.bans[ban.UserID] = &stored
+	return nil
+}
+
+// Unban kullanıcının yasağını kaldırır
+func (s *MemoryModerationStore) Unban(ctx context.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.bans[userID]; !ok {
+		return ErrNotFound
+	}
+	delete(s.bans, userID)
+	return nil
+}
+
+// Bans tüm yasakları en yeniden eskiye döndürür
+func (s *MemoryModerationStore) Bans(ctx context.Context) ([]models.LeaderboardBan, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	bans := make([]models.LeaderboardBan, 0, len(s.bans))
+	for _, ban := range s.bans {
+		bans = append(bans, *ban)
+	}
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].CreatedAt.After(bans[j].CreatedAt)
+	})
+	return bans, nil
+}
+
+// IsBanned kullanıcının yasaklı olup olmadığını döndürür
+func (s *MemoryModerationStore) IsBanned(ctx context.Context, userID string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.bans[userID]
+	return ok, nil
+}
+
+// AppendAudit audit log'a kayıt ekler
+func (s *MemoryModerationStore) AppendAudit(ctx context.Context, entry *models.AuditLogEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry.ID = primitive.NewObjectID()
+	s.audit = append(s.audit, *entry)
+	return nil
+}
+
+// AuditLog kayıtları en yeniden eskiye sayfalanmış olarak döndürür
+func (s *MemoryModerationStore) AuditLog(ctx context.Context, limit, offset int) ([]models.AuditLogEntry, int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	total := len(s.audit)
+	if offset > total {
+		offset = total
+	}
+	end := offset + limit
+	if limit <= 0 || end > total {
+		end = total
+	}
+
+	// Kayıtlar eklenme sırasıyla tutulur; en yenisi sondadır
+	entries := make([]models.AuditLogEntry, 0, end-offset)
+	for i := offset; i < end; i++ {
+		entries = append(entries, s.audit[total-1-i])
+	}
+	return entries, int64(total), nil
+}
+
+// memoryPinger bellek içi depolar her zaman erişilebilirdir
+type memoryPinger struct{}
+
+func (memoryPinger) Ping(ctx context.Context) error {
+	return nil
+}
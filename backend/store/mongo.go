@@ -0,0 +1,516 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"balatro-backend/migrations"
+	"balatro-backend/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDB koleksiyon adları
+const (
+	CollectionPlayerStates       = "player_states"
+	CollectionHighscores         = "highscores"
+	CollectionRejectedHighscores = "rejected_highscores"
+	CollectionRuns               = "runs"
+	CollectionProfiles           = "profiles"
+	CollectionUsers              = "users"
+	CollectionLeaderboardBans    = "leaderboard_bans"
+	CollectionAuditLog           = "admin_audit_log"
+)
+
+// NewMongoStores MongoDB veritabanı üzerinde çalışan depoları oluşturur
+func NewMongoStores(db *mongo.Database) Stores {
+	return Stores{
+		PlayerStates: &mongoPlayerStates{collection: db.Collection(CollectionPlayerStates)},
+		Highscores: &mongoHighscores{
+			collection: db.Collection(CollectionHighscores),
+			rejected:   db.Collection(CollectionRejectedHighscores),
+		},
+		Runs:     &mongoRuns{collection: db.Collection(CollectionRuns)},
+		Profiles: &mongoProfiles{collection: db.Collection(CollectionProfiles)},
+		Users:    &mongoUsers{collection: db.Collection(CollectionUsers)},
+		Accounts: &mongoAccounts{db: db},
+		Moderation: &mongoModeration{
+			bans:  db.Collection(CollectionLeaderboardBans),
+			audit: db.Collection(CollectionAuditLog),
+		},
+		Health: &mongoPinger{client: db.Client()},
+	}
+}
+
+// findOne tek bir belgeyi decode eder; belge yoksa ErrNotFound döner
+func findOne(ctx context.Context, collection *mongo.Collection, filter interface{}, out interface{}, opts ...*options.FindOneOptions) error {
+	err := collection.FindOne(ctx, filter, opts...).Decode(out)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return ErrNotFound
+	}
+	return err
+}
+
+type mongoPlayerStates struct {
+	collection *mongo.Collection
+}
+
+func (s *mongoPlayerStates) Get(ctx context.Context, userID string) (*models.PlayerState, error) {
+	var state models.PlayerState
+	if err := findOne(ctx, s.collection, bson.M{"userId": userID}, &state); err != nil {
+		return nil, err
+	}
+	// Eski kayıtlar güncel şemaya yükseltilir (bir sonraki kayıtta yazılır)
+	migrations.UpgradePlayerState(&state)
+	return &state, nil
+}
+
+func (s *mongoPlayerStates) Upsert(ctx context.Context, state *models.PlayerState) (UpsertResult, error) {
+	// Upsert işlemi (varsa güncelle, yoksa oluştur)
+	filter := bson.M{"userId": state.UserID}
+	update := bson.M{"$set": state}
+	opts := options.Update().SetUpsert(true)
+
+	result, err := s.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return UpsertResult{}, err
+	}
+	return UpsertResult{
+		UpsertedID: result.UpsertedID,
+		Matched:    result.MatchedCount > 0,
+		Modified:   result.ModifiedCount > 0,
+	}, nil
+}
+
+func (s *mongoPlayerStates) Delete(ctx context.Context, userID string) error {
+	result, err := s.collection.DeleteOne(ctx, bson.M{"userId": userID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+type mongoHighscores struct {
+	collection *mongo.Collection
+	rejected   *mongo.Collection
+}
+
+// highscoreFilter filtreyi MongoDB sorgusuna çevirir
+func highscoreFilter(filter HighscoreFilter) bson.M {
+	query := bson.M{}
+	if filter.UserID != "" {
+		query["userId"] = filter.UserID
+	}
+	if filter.VisibleOnly {
+		query["flagged"] = bson.M{"$ne": true}
+		query["deletedAt"] = nil // Alan yoksa veya null ise eşleşir
+		query["banned"] = bson.M{"$ne": true}
+	}
+	if filter.Deck != "" {
+		query["deck"] = filter.Deck
+	}
+	if filter.Stake != "" {
+		query["stake"] = filter.Stake
+	}
+	return query
+}
+
+func (s *mongoHighscores) Insert(ctx context.Context, highscore *models.Highscore) error {
+	result, err := s.collection.InsertOne(ctx, highscore)
+	if err != nil {
+		return err
+	}
+	highscore.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (s *mongoHighscores) List(ctx context.Context, filter HighscoreFilter, limit, offset int) ([]models.Highscore, int64, error) {
+	query := highscoreFilter(filter)
+
+	// Skor azalan, tarih azalan
+	opts := options.Find().
+		SetSort(bson.D{{Key: "score", Value: -1}, {Key: "dateAchieved", Value: -1}}).
+		SetLimit(int64(limit)).
+		SetSkip(int64(offset))
+
+	cursor, err := s.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	highscores := []models.Highscore{}
+	if err := cursor.All(ctx, &highscores); err != nil {
+		return nil, 0, err
+	}
+
+	// Toplam sayı (pagination için)
+	total, err := s.collection.CountDocuments(ctx, query)
+	if err != nil {
+		total = int64(len(highscores)) // Fallback
+	}
+	return highscores, total, nil
+}
+
+func (s *mongoHighscores) Best(ctx context.Context, userID string) (*models.Highscore, error) {
+	var highscore models.Highscore
+	opts := options.FindOne().SetSort(bson.D{{Key: "score", Value: -1}})
+	query := highscoreFilter(HighscoreFilter{UserID: userID, VisibleOnly: true})
+	if err := findOne(ctx, s.collection, query, &highscore, opts); err != nil {
+		return nil, err
+	}
+	return &highscore, nil
+}
+
+func (s *mongoHighscores) CountAbove(ctx context.Context, filter HighscoreFilter, score int64) (int64, error) {
+	query := highscoreFilter(filter)
+	query["score"] = bson.M{"$gt": score}
+	return s.collection.CountDocuments(ctx, query)
+}
+
+func (s *mongoHighscores) Get(ctx context.Context, id primitive.ObjectID) (*models.Highscore, error) {
+	var highscore models.Highscore
+	if err := findOne(ctx, s.collection, bson.M{"_id": id}, &highscore); err != nil {
+		return nil, err
+	}
+	return &highscore, nil
+}
+
+func (s *mongoHighscores) Moderate(ctx context.Context, id primitive.ObjectID, moderation HighscoreModeration) error {
+	update := bson.M{"$set": bson.M{
+		"flagged":    moderation.Flagged,
+		"flagReason": moderation.FlagReason,
+		"deletedAt":  moderation.DeletedAt,
+	}}
+	result, err := s.collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *mongoHighscores) FirstSubmission(ctx context.Context, highscore models.Highscore) (*models.Highscore, error) {
+	query := bson.M{
+		"seed":       highscore.Seed,
+		"score":      highscore.Score,
+		"finalBlind": highscore.FinalBlind,
+		"$or": bson.A{
+			bson.M{"dateAchieved": bson.M{"$lt": highscore.DateAchieved}},
+			bson.M{"dateAchieved": highscore.DateAchieved, "_id": bson.M{"$lt": highscore.ID}},
+		},
+	}
+	if highscore.Seed == "" {
+		query["userId"] = highscore.UserID
+	} else {
+		query["deck"] = highscore.Deck
+		query["stake"] = highscore.Stake
+	}
+
+	var first models.Highscore
+	opts := options.FindOne().SetSort(bson.D{{Key: "dateAchieved", Value: 1}, {Key: "_id", Value: 1}})
+	if err := findOne(ctx, s.collection, query, &first, opts); err != nil {
+		return nil, err
+	}
+	return &first, nil
+}
+
+func (s *mongoHighscores) SetBanned(ctx context.Context, userID string, banned bool) error {
+	_, err := s.collection.UpdateMany(ctx, bson.M{"userId": userID}, bson.M{"$set": bson.M{"banned": banned}})
+	return err
+}
+
+func (s *mongoHighscores) InsertRejected(ctx context.Context, rejected *models.RejectedHighscore) error {
+	result, err := s.rejected.InsertOne(ctx, rejected)
+	if err != nil {
+		return err
+	}
+	rejected.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+type mongoRuns struct {
+	collection *mongo.Collection
+}
+
+func (s *mongoRuns) Create(ctx context.Context, run *models.Run) error {
+	result, err := s.collection.InsertOne(ctx, run)
+	if err != nil {
+		return err
+	}
+	run.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (s *mongoRuns) Get(ctx context.Context, id primitive.ObjectID) (*models.Run, error) {
+	var run models.Run
+	if err := findOne(ctx, s.collection, bson.M{"_id": id}, &run); err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+func (s *mongoRuns) Update(ctx context.Context, run *models.Run, expectedVersion int) error {
+	filter := bson.M{"_id": run.ID, "version": expectedVersion}
+	result, err := s.collection.ReplaceOne(ctx, filter, run)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+type mongoProfiles struct {
+	collection *mongo.Collection
+}
+
+func (s *mongoProfiles) Get(ctx context.Context, userID string) (*models.Profile, error) {
+	var profile models.Profile
+	if err := findOne(ctx, s.collection, bson.M{"userId": userID}, &profile); err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (s *mongoProfiles) Save(ctx context.Context, profile *models.Profile) error {
+	// Upsert işlemi (varsa değiştir, yoksa oluştur)
+	filter := bson.M{"userId": profile.UserID}
+	opts := options.Replace().SetUpsert(true)
+	_, err := s.collection.ReplaceOne(ctx, filter, profile, opts)
+	return err
+}
+
+type mongoUsers struct {
+	collection *mongo.Collection
+}
+
+func (s *mongoUsers) Create(ctx context.Context, user *models.User) error {
+	result, err := s.collection.InsertOne(ctx, user)
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrDuplicate
+	}
+	if err != nil {
+		return err
+	}
+	user.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (s *mongoUsers) GetByID(ctx context.Context, id string) (*models.User, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	var user models.User
+	if err := findOne(ctx, s.collection, bson.M{"_id": objectID}, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (s *mongoUsers) GetByUsername(ctx context.Context, username string) (*models.User, error) {
+	var user models.User
+	if err := findOne(ctx, s.collection, bson.M{"username": username}, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (s *mongoUsers) GetByDeviceToken(ctx context.Context, tokenHash string) (*models.User, error) {
+	var user models.User
+	if err := findOne(ctx, s.collection, bson.M{"deviceTokenHash": tokenHash}, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (s *mongoUsers) SetRole(ctx context.Context, username, role string) error {
+	result, err := s.collection.UpdateOne(ctx, bson.M{"username": username}, bson.M{"$set": bson.M{"role": role}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SupportsTransactions MongoDB sunucusunun transaction destekleyip
+// desteklemediğini döndürür. Transaction'lar yalnızca replica set üyelerinde ve
+// mongos üzerinden çalışır; tek başına (standalone) sunucuda çalışmaz.
+func SupportsTransactions(ctx context.Context, db *mongo.Database) (bool, error) {
+	var hello struct {
+		SetName string `bson:"setName"` // Replica set üyesiyse set adı
+		Msg     string `bson:"msg"`     // mongos ise "isdbgrid"
+	}
+	if err := db.RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		return false, err
+	}
+	return hello.SetName != "" || hello.Msg == "isdbgrid", nil
+}
+
+type mongoAccounts struct {
+	db *mongo.Database
+}
+
+// guestOwnedFields misafir yükseltilirken kullanıcı ID'si taşınan koleksiyon alanları
+var guestOwnedFields = []struct {
+	collection string
+	fields     []string
+}{
+	{CollectionPlayerStates, []string{"userId"}},
+	{CollectionHighscores, []string{"userId"}},
+	{CollectionRejectedHighscores, []string{"userId"}},
+	{CollectionRuns, []string{"userId", "state.userId"}},
+	{CollectionProfiles, []string{"userId"}},
+	{CollectionLeaderboardBans, []string{"userId"}}, // Yasak yükseltmeden sonra da geçerli kalır
+}
+
+// UpgradeGuest tüm değişiklikleri tek bir MongoDB transaction'ında uygular
+// (transaction'lar replica set veya Atlas gerektirir)
+func (a *mongoAccounts) UpgradeGuest(ctx context.Context, guestID string, user *models.User) error {
+	guestObjectID, err := primitive.ObjectIDFromHex(guestID)
+	if err != nil {
+		return ErrNotFound
+	}
+
+	session, err := a.db.Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		users := a.db.Collection(CollectionUsers)
+		deleted, err := users.DeleteOne(sc, bson.M{"_id": guestObjectID, "guest": true})
+		if err != nil {
+			return nil, err
+		}
+		if deleted.DeletedCount == 0 {
+			return nil, ErrNotFound
+		}
+
+		user.ID = primitive.NewObjectID()
+		if _, err := users.InsertOne(sc, user); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, ErrDuplicate
+			}
+			return nil, err
+		}
+
+		userID := user.ID.Hex()
+		for _, owned := range guestOwnedFields {
+			collection := a.db.Collection(owned.collection)
+			for _, field := range owned.fields {
+				update := bson.M{"$set": bson.M{field: userID}}
+				if _, err := collection.UpdateMany(sc, bson.M{field: guestID}, update); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		user.ID = primitive.NilObjectID
+	}
+	return err
+}
+
+type mongoModeration struct {
+	bans  *mongo.Collection
+	audit *mongo.Collection
+}
+
+func (s *mongoModeration) Ban(ctx context.Context, ban *models.LeaderboardBan) error {
+	// Upsert işlemi (varsa değiştir, yoksa oluştur)
+	filter := bson.M{"userId": ban.UserID}
+	update := bson.M{"$set": bson.M{
+		"reason":    ban.Reason,
+		"bannedBy":  ban.BannedBy,
+		"createdAt": ban.CreatedAt,
+	}}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	return s.bans.FindOneAndUpdate(ctx, filter, update, opts).Decode(ban)
+}
+
+func (s *mongoModeration) Unban(ctx context.Context, userID string) error {
+	result, err := s.bans.DeleteOne(ctx, bson.M{"userId": userID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *mongoModeration) Bans(ctx context.Context) ([]models.LeaderboardBan, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}})
+	cursor, err := s.bans.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	bans := []models.LeaderboardBan{}
+	if err := cursor.All(ctx, &bans); err != nil {
+		return nil, err
+	}
+	return bans, nil
+}
+
+func (s *mongoModeration) IsBanned(ctx context.Context, userID string) (bool, error) {
+	count, err := s.bans.CountDocuments(ctx, bson.M{"userId": userID}, options.Count().SetLimit(1))
+	return count > 0, err
+}
+
+func (s *mongoModeration) AppendAudit(ctx context.Context, entry *models.AuditLogEntry) error {
+	result, err := s.audit.InsertOne(ctx, entry)
+	if err != nil {
+		return err
+	}
+	entry.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (s *mongoModeration) AuditLog(ctx context.Context, limit, offset int) ([]models.AuditLogEntry, int64, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdAt", Value: -1}}).
+		SetLimit(int64(limit)).
+		SetSkip(int64(offset))
+
+	cursor, err := s.audit.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	entries := []models.AuditLogEntry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.audit.CountDocuments(ctx, bson.M{})
+	if err != nil {
+		total = int64(len(entries)) // Fallback
+	}
+	return entries, total, nil
+}
+
+type mongoPinger struct {
+	client *mongo.Client
+}
+
+func (p *mongoPinger) Ping(ctx context.Context) error {
+	return p.client.Ping(ctx, nil)
+}
@@ -0,0 +1,160 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"balatro-backend/models"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	// ErrNotFound aranan kayıt yoksa döner
+	ErrNotFound = errors.New("kayıt bulunamadı")
+	// ErrVersionConflict kayıt okunduktan sonra başka bir istek tarafından güncellendiyse döner
+	ErrVersionConflict = errors.New("kayıt başka bir istek tarafından güncellendi")
+	// ErrDuplicate benzersiz olması gereken bir alan zaten kullanılıyorsa döner
+	ErrDuplicate = errors.New("kayıt zaten mevcut")
+	// ErrUnsupported işlem mevcut depolama kurulumunda yapılamıyorsa döner
+	ErrUnsupported = errors.New("işlem bu depolama kurulumunda desteklenmiyor")
+)
+
+// UpsertResult upsert işleminin sonucu
+type UpsertResult struct {
+	UpsertedID interface{} // Yeni oluşturulduysa kaydın ID'si
+	Matched    bool        // Mevcut bir kayıt bulundu mu
+	Modified   bool        // Mevcut kayıt değişti mi
+}
+
+// HighscoreFilter yüksek skor listesi filtresi (boş alanlar filtrelenmez)
+type HighscoreFilter struct {
+	UserID      string
+	Deck        string
+	Stake       string
+	VisibleOnly bool // İşaretlenmiş, silinmiş ve yasaklı kullanıcılara ait skorları hariç tutar
+}
+
+// HighscoreModeration skorun moderasyon alanları
+type HighscoreModeration struct {
+	Flagged    bool
+	FlagReason string
+	DeletedAt  *time.Time // nil ise skor silinmemiş
+}
+
+// PlayerStateStore kullanıcı başına tek oyun durumunu saklar
+type PlayerStateStore interface {
+	// Get kullanıcının oyun durumunu döndürür; yoksa ErrNotFound
+	Get(ctx context.Context, userID string) (*models.PlayerState, error)
+	// Upsert oyun durumunu kullanıcı ID'sine göre oluşturur veya günceller
+	Upsert(ctx context.Context, state *models.PlayerState) (UpsertResult, error)
+	// Delete kullanıcının oyun durumunu siler; yoksa ErrNotFound
+	Delete(ctx context.Context, userID string) error
+}
+
+// HighscoreStore kabul edilen ve reddedilen yüksek skorları saklar
+type HighscoreStore interface {
+	// Insert yüksek skoru kaydeder ve ID'sini atar
+	Insert(ctx context.Context, highscore *models.Highscore) error
+	// List filtreye uyan skorları skor ve tarih azalan sırayla döndürür, toplam sayıyla birlikte
+	List(ctx context.Context, filter HighscoreFilter, limit, offset int) ([]models.Highscore, int64, error)
+	// Best kullanıcının işaretlenmemiş ve silinmemiş en yüksek skorunu döndürür; yoksa ErrNotFound
+	Best(ctx context.Context, userID string) (*models.Highscore, error)
+	// CountAbove filtreye uyan skorlardan verilen skordan yüksek olanların sayısını döndürür (sıralama için)
+	CountAbove(ctx context.Context, filter HighscoreFilter, score int64) (int64, error)
+	// Get ID ile skoru döndürür; yoksa ErrNotFound
+	Get(ctx context.Context, id primitive.ObjectID) (*models.Highscore, error)
+	// Moderate skorun moderasyon alanlarını değiştirir; yoksa ErrNotFound
+	Moderate(ctx context.Context, id primitive.ObjectID, moderation HighscoreModeration) error
+	// FirstSubmission aynı run'ın (seed, skor, blind, deste ve stake; seed yoksa
+	// kullanıcı, skor ve blind) bu skordan önceki ilk gönderimini döndürür; yoksa ErrNotFound
+	FirstSubmission(ctx context.Context, highscore models.Highscore) (*models.Highscore, error)
+	// SetBanned kullanıcının tüm skorlarının yasak işaretini değiştirir (liderlik tablosu yasağı)
+	SetBanned(ctx context.Context, userID string, banned bool) error
+	// InsertRejected doğrulanamayan gönderimi moderasyon için kaydeder ve ID'sini atar
+	InsertRejected(ctx context.Context, rejected *models.RejectedHighscore) error
+}
+
+// RunStore sunucu tarafı run'ları saklar
+type RunStore interface {
+	// Create yeni run'ı kaydeder ve ID'sini atar
+	Create(ctx context.Context, run *models.Run) error
+	// Get ID ile run'ı döndürür; yoksa ErrNotFound
+	Get(ctx context.Context, id primitive.ObjectID) (*models.Run, error)
+	// Update run'ı yalnızca saklanan sürüm expectedVersion ise değiştirir; değilse ErrVersionConflict
+	Update(ctx context.Context, run *models.Run, expectedVersion int) error
+}
+
+// ProfileStore kullanıcı başına tek profili saklar
+type ProfileStore interface {
+	// Get kullanıcının profilini döndürür; yoksa ErrNotFound
+	Get(ctx context.Context, userID string) (*models.Profile, error)
+	// Save profili kullanıcı ID'sine göre oluşturur veya değiştirir
+	Save(ctx context.Context, profile *models.Profile) error
+}
+
+// UserStore kullanıcı hesaplarını saklar
+type UserStore interface {
+	// Create yeni kullanıcıyı kaydeder ve ID'sini atar; kullanıcı adı alınmışsa ErrDuplicate
+	Create(ctx context.Context, user *models.User) error
+	// GetByID ID ile kullanıcıyı döndürür; yoksa ErrNotFound
+	GetByID(ctx context.Context, id string) (*models.User, error)
+	// GetByUsername kullanıcı adıyla kullanıcıyı döndürür; yoksa ErrNotFound
+	GetByUsername(ctx context.Context, username string) (*models.User, error)
+	// GetByDeviceToken cihaz token'ı özetiyle misafir kullanıcıyı döndürür; yoksa ErrNotFound
+	GetByDeviceToken(ctx context.Context, tokenHash string) (*models.User, error)
+	// SetRole kullanıcı adıyla bulunan kullanıcının rolünü değiştirir; yoksa ErrNotFound
+	SetRole(ctx context.Context, username, role string) error
+}
+
+// ModerationStore liderlik tablosu yasaklarını ve admin audit log'unu saklar
+type ModerationStore interface {
+	// Ban kullanıcıyı yasaklar; zaten yasaklıysa kaydı değiştirir
+	Ban(ctx context.Context, ban *models.LeaderboardBan) error
+	// Unban kullanıcının yasağını kaldırır; yoksa ErrNotFound
+	Unban(ctx context.Context, userID string) error
+	// Bans tüm yasakları en yeniden eskiye döndürür
+	Bans(ctx context.Context) ([]models.LeaderboardBan, error)
+	// IsBanned kullanıcının yasaklı olup olmadığını döndürür
+	IsBanned(ctx context.Context, userID string) (bool, error)
+	// AppendAudit audit log'a kayıt ekler ve ID'sini atar
+	AppendAudit(ctx context.Context, entry *models.AuditLogEntry) error
+	// AuditLog kayıtları en yeniden eskiye sayfalanmış olarak, toplam sayıyla döndürür
+	AuditLog(ctx context.Context, limit, offset int) ([]models.AuditLogEntry, int64, error)
+}
+
+// AccountStore birden fazla koleksiyona dokunan hesap işlemleri
+type AccountStore interface {
+	// UpgradeGuest misafir hesabını tam hesaba çevirir: user'ı oluşturur, misafirin
+	// oyun durumu, run, skor ve profil kayıtlarını yeni kullanıcı ID'sine taşır ve
+	// misafiri siler. İşlem bütündür; hata olursa hiçbir kayıt değişmez. Kullanıcı
+	// adı alınmışsa ErrDuplicate, misafir yoksa ErrNotFound döner.
+	UpgradeGuest(ctx context.Context, guestID string, user *models.User) error
+}
+
+// UnsupportedAccounts hesap işlemlerini ErrUnsupported ile reddeder; işlemleri
+// bütün olarak uygulayamayan kurulumlarda AccountStore yerine kullanılır
+type UnsupportedAccounts struct{}
+
+// UpgradeGuest her zaman ErrUnsupported döner
+func (UnsupportedAccounts) UpgradeGuest(ctx context.Context, guestID string, user *models.User) error {
+	return ErrUnsupported
+}
+
+// Pinger depolamanın erişilebilir olup olmadığını kontrol eder
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Stores API'nin kullandığı tüm depolar
+type Stores struct {
+	PlayerStates PlayerStateStore
+	Highscores   HighscoreStore
+	Runs         RunStore
+	Profiles     ProfileStore
+	Users        UserStore
+	Accounts     AccountStore
+	Moderation   ModerationStore
+	Health       Pinger
+}
@@ -0,0 +1,106 @@
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"balatro-backend/game/cards"
+	"balatro-backend/game/decks"
+	"balatro-backend/game/run"
+	"balatro-backend/models"
+)
+
+// Oyun kuralı hata kodları
+const (
+	CodeHandSize      = "hand_size"      // Eldeki kart sayısı el boyutunu aşıyor
+	CodeDeckSize      = "deck_size"      // Deste ve el toplamı deste boyutunu aşıyor
+	CodeJokerSlots    = "joker_slots"    // Joker sayısı slot sınırını aşıyor
+	CodeInvalidCard   = "invalid_card"   // Kartın enhancement birleşimi geçersiz
+	CodeDuplicateCard = "duplicate_card" // Aynı karttan destenin izin verdiğinden fazla var
+)
+
+// PlayerState etiketlerle ifade edilemeyen oyun kurallarını doğrular: kart
+// sayıları, joker slotları ve enhancement grupları. Etiket doğrulamasından
+// sonra çağrılır. Aynı tür ve değerdeki kartlar Checkered deste ve tür/değer
+// değiştiren tarot kartlarıyla meşru olarak oluşur; tamamen aynı kartlar
+// (enhancement, edition ve seal dahil) yalnızca decks.MaxCopies kadar olabilir.
+func PlayerState(request *models.CreatePlayerStateRequest) []models.FieldError {
+	var result []models.FieldError
+
+	if n := len(request.HandCards); n > run.HandSize {
+		result = append(result, models.FieldError{
+			Field:   "handCards",
+			Code:    CodeHandSize,
+			Message: fmt.Sprintf("elde en fazla %d kart olabilir (%d gönderildi)", run.HandSize, n),
+		})
+	}
+	if n, max := len(request.DeckCards)+len(request.HandCards), decks.MaxCards(); n > max {
+		result = append(result, models.FieldError{
+			Field:   "deckCards",
+			Code:    CodeDeckSize,
+			Message: fmt.Sprintf("deste ve eldeki kartlar toplamda en fazla %d olabilir (%d gönderildi)", max, n),
+		})
+	}
+	if n := len(request.Jokers); n > run.MaxJokers {
+		result = append(result, models.FieldError{
+			Field:   "jokers",
+			Code:    CodeJokerSlots,
+			Message: fmt.Sprintf("en fazla %d joker olabilir (%d gönderildi)", run.MaxJokers, n),
+		})
+	}
+
+	result = append(result, cardErrors("deckCards", request.DeckCards)...)
+	result = append(result, cardErrors("handCards", request.HandCards)...)
+	result = append(result, duplicateErrors(request.DeckCards, request.HandCards)...)
+	return result
+}
+
+// duplicateErrors deste ve eldeki kartlarda decks.MaxCopies'i aşan her aynı
+// kopyayı hata olarak döndürür
+func duplicateErrors(deckCards, handCards []models.Card) []models.FieldError {
+	var result []models.FieldError
+	max := decks.MaxCopies()
+	counts := map[string]int{}
+	check := func(field string, list []models.Card) {
+		for i, card := range list {
+			key := cardKey(card)
+			counts[key]++
+			if counts[key] > max {
+				result = append(result, models.FieldError{
+					Field:   fmt.Sprintf("%s[%d]", field, i),
+					Code:    CodeDuplicateCard,
+					Message: fmt.Sprintf("aynı karttan en fazla %d olabilir", max),
+				})
+			}
+		}
+	}
+	check("deckCards", deckCards)
+	check("handCards", handCards)
+	return result
+}
+
+// cardKey kartı tür, değer, enhancement'lar (sırasız), edition ve seal ile tanımlar
+func cardKey(card models.Card) string {
+	enhancements := make([]string, len(card.Enhancements))
+	for i, e := range card.Enhancements {
+		enhancements[i] = string(e)
+	}
+	sort.Strings(enhancements)
+	return strings.Join([]string{card.Suit, card.Value, strings.Join(enhancements, "+"), string(card.Edition), string(card.Seal)}, "|")
+}
+
+// cardErrors kartları cards.Validate ile doğrular (aynı gruptan birden fazla enhancement gibi)
+func cardErrors(field string, list []models.Card) []models.FieldError {
+	var result []models.FieldError
+	for i, card := range list {
+		if err := cards.Validate(card); err != nil {
+			result = append(result, models.FieldError{
+				Field:   fmt.Sprintf("%s[%d]", field, i),
+				Code:    CodeInvalidCard,
+				Message: err.Error(),
+			})
+		}
+	}
+	return result
+}
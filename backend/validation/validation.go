@@ -0,0 +1,182 @@
+// Package validation request doğrulama kurallarını gin'in validator'ına kaydeder
+// ve bağlama hatalarını alan bazında models.FieldError listesine çevirir.
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"balatro-backend/game/blinds"
+	"balatro-backend/game/cards"
+	"balatro-backend/game/hands"
+	"balatro-backend/game/jokers"
+	"balatro-backend/game/tarots"
+	"balatro-backend/game/vouchers"
+	"balatro-backend/models"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// Gövdenin tamamına ait hata kodları
+const (
+	CodeMalformed = "malformed_json" // JSON parse edilemedi
+	CodeEmpty     = "empty_body"     // Gövde boş
+	CodeType      = "type"           // Alan beklenen türde değil
+)
+
+// rule özel validator etiketi: doğrulama fonksiyonu ve hata mesajındaki öğe adı
+type rule struct {
+	valid func(string) bool
+	noun  string
+}
+
+// rules oyun kataloglarına dayalı özel etiketler
+var rules = map[string]rule{
+	"suit":        {cards.IsValidSuit, "kart türü"},
+	"card_value":  {cards.IsValidValue, "kart değeri"},
+	"enhancement": {func(s string) bool { return cards.IsValidEnhancement(models.Enhancement(s)) }, "enhancement"},
+	"edition":     {func(s string) bool { return cards.IsValidEdition(models.Edition(s)) }, "edition"},
+	"seal":        {func(s string) bool { return cards.IsValidSeal(models.Seal(s)) }, "seal"},
+	"joker_id":    {jokers.IsKnown, "joker"},
+	"tarot_id":    {tarots.IsKnown, "tarot kartı"},
+	"voucher_id":  {vouchers.IsKnown, "voucher"},
+	"blind_kind":  {isBlindKind, "blind türü"},
+	"hand_name":   {isHandName, "el türü"},
+}
+
+// Register özel etiketleri gin'in varsayılan validator'ına kaydeder ve hata
+// yollarında Go alan adları yerine JSON adlarının kullanılmasını sağlar.
+// Route'lar kurulmadan önce bir kez çağrılmalıdır.
+func Register() error {
+	engine, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return errors.New("gin validator motoru go-playground/validator değil")
+	}
+
+	engine.RegisterTagNameFunc(jsonName)
+	for tag, r := range rules {
+		valid := r.valid
+		err := engine.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+			return valid(fl.Field().String())
+		})
+		if err != nil {
+			return fmt.Errorf("%s etiketi kaydedilemedi: %w", tag, err)
+		}
+	}
+	return nil
+}
+
+// Errors ShouldBindJSON hatasını alan bazında hatalara çevirir
+func Errors(err error) []models.FieldError {
+	var validationErrors validator.ValidationErrors
+	var typeError *json.UnmarshalTypeError
+	var syntaxError *json.SyntaxError
+
+	switch {
+	case errors.As(err, &validationErrors):
+		result := make([]models.FieldError, 0, len(validationErrors))
+		for _, fe := range validationErrors {
+			result = append(result, models.FieldError{
+				Field:   fieldPath(fe.Namespace()),
+				Code:    fe.Tag(),
+				Message: message(fe),
+			})
+		}
+		return result
+	case errors.As(err, &typeError):
+		return []models.FieldError{{
+			Field:   typeError.Field,
+			Code:    CodeType,
+			Message: fmt.Sprintf("%s türünde olmalı", typeError.Type.Kind()),
+		}}
+	case errors.As(err, &syntaxError):
+		return []models.FieldError{{
+			Code:    CodeMalformed,
+			Message: fmt.Sprintf("JSON parse edilemedi (bayt %d)", syntaxError.Offset),
+		}}
+	case errors.Is(err, io.EOF):
+		return []models.FieldError{{Code: CodeEmpty, Message: "request gövdesi boş"}}
+	default:
+		return []models.FieldError{{Code: CodeMalformed, Message: err.Error()}}
+	}
+}
+
+// message etiket için okunabilir hata mesajı üretir
+func message(fe validator.FieldError) string {
+	if r, ok := rules[fe.Tag()]; ok {
+		return fmt.Sprintf("bilinmeyen %s: %v", r.noun, fe.Value())
+	}
+
+	switch fe.Tag() {
+	case "required":
+		return "zorunlu alan"
+	case "min":
+		return fmt.Sprintf("en az %s %s", fe.Param(), unit(fe.Kind()))
+	case "max":
+		return fmt.Sprintf("en fazla %s %s", fe.Param(), unit(fe.Kind()))
+	case "oneof":
+		return "şunlardan biri olmalı: " + fe.Param()
+	case "unique":
+		return "tekrarlanan değer içeremez"
+	default:
+		return fmt.Sprintf("geçersiz değer (%s)", fe.Tag())
+	}
+}
+
+// unit min/max mesajındaki birimi alanın türüne göre seçer
+func unit(kind reflect.Kind) string {
+	switch kind {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return "öğe içermeli"
+	case reflect.String:
+		return "karakter olmalı"
+	default:
+		return "olmalı"
+	}
+}
+
+// fieldPath validator namespace'inden kök struct adını atar:
+// "CreatePlayerStateRequest.deckCards[3].suit" -> "deckCards[3].suit"
+func fieldPath(namespace string) string {
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
+
+// jsonName alanın JSON adını döndürür (json:"-" alanlar için boş)
+func jsonName(field reflect.StructField) string {
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// isBlindKind blind türünün SMALL, BIG veya BOSS olup olmadığını döndürür
+func isBlindKind(kind string) bool {
+	for _, k := range blinds.Order {
+		if string(k) == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// isHandName el adının tanımlı olup olmadığını döndürür (planetLevels anahtarları)
+func isHandName(name string) bool {
+	for _, info := range hands.All() {
+		if info.Name == name {
+			return true
+		}
+	}
+	return false
+}
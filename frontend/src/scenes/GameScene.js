@@ -671,9 +671,10 @@ export default class GameScene extends Phaser.Scene {
         // Temel çarpan hesaplama
         let totalMultiplier = handResult.baseMultiplier
         
-        // Kartların enhancement çarpanlarını ekle
+        // Kartların enhancement çarpanlarını sırayla uygula (önce +çarpan, sonra x çarpan)
         selectedCards.forEach(card => {
             totalMultiplier += card.getEnhancementMultiplierBonus ? card.getEnhancementMultiplierBonus() : 0
+            totalMultiplier *= card.getEnhancementXMultiplier ? card.getEnhancementXMultiplier() : 1
         })
         
         // Joker efektlerini hesapla
@@ -1006,17 +1007,17 @@ export default class GameScene extends Phaser.Scene {
                 score: finalScore,
                 finalBlind: finalBlind,
                 jokersUsed: this.jokers.map(joker => joker.name || joker.id),
-                seed: 'game_seed_' + Date.now()
+                runId: this.runId
             })
             
-            // HighscoreAPI.save(userId, playerName, score, finalBlind, jokersUsed, seed) formatında çağır
+            // HighscoreAPI.save(userId, playerName, score, finalBlind, jokersUsed, runId, actions) formatında çağır
             const response = await HighscoreAPI.save(
                 this.currentUserId, 
                 playerName, 
                 finalScore, 
                 finalBlind,
                 this.jokers.map(joker => joker.name || joker.id), // Joker isimleri
-                'game_seed_' + Date.now() // Basit seed
+                this.runId // Sunucudaki run (seed sunucuda saklanır)
             )
             
             if (APIUtils.isSuccess(response)) {
@@ -107,7 +107,7 @@ export default class ShopScene extends Phaser.Scene {
                     id: joker.id,
                     name: joker.name,
                     description: joker.description,
-                    price: basePrice + Math.floor(Math.random() * 10),
+                    price: joker.getPrice(),
                     rarity: joker.rarity,
                     data: joker
                 }
@@ -3,6 +3,12 @@
 // API base URL - geliştirme ortamı için
 const API_BASE_URL = 'http://localhost:8080/api'
 
+// Oturum token'ının localStorage anahtarı
+const AUTH_TOKEN_KEY = 'balatro_auth_token'
+
+// Misafir cihaz token'ının localStorage anahtarı
+const DEVICE_TOKEN_KEY = 'balatro_device_token'
+
 // HTTP helper fonksiyonu
 async function apiRequest(endpoint, options = {}) {
     const url = `${API_BASE_URL}${endpoint}`
@@ -13,6 +19,12 @@ async function apiRequest(endpoint, options = {}) {
         },
     }
     
+    // Oturum varsa token'ı ekle
+    const token = AuthAPI.getToken()
+    if (token) {
+        defaultOptions.headers['Authorization'] = `Bearer ${token}`
+    }
+    
     const requestOptions = {
         ...defaultOptions,
         ...options,
@@ -29,7 +41,13 @@ async function apiRequest(endpoint, options = {}) {
         const data = await response.json()
         
         if (!response.ok) {
-            throw new Error(data.error || data.message || `HTTP ${response.status}`)
+            // Doğrulama hataları alan bazında gelir: [{field, code, message}]
+            const fieldErrors = (data.errors || []).map(e => e.field ? `${e.field}: ${e.message}` : e.message)
+            const error = new Error(fieldErrors.length > 0
+                ? `${data.message} (${fieldErrors.join('; ')})`
+                : data.error || data.message || `HTTP ${response.status}`)
+            error.fieldErrors = data.errors || []
+            throw error
         }
         
         console.log(`✅ API Response:`, data)
@@ -40,7 +58,79 @@ async function apiRequest(endpoint, options = {}) {
     }
 }
 
-// Oyun durumu API fonksiyonları
+// Hesap API fonksiyonları
+export const AuthAPI = {
+    // Yeni hesap oluştur ve oturumu sakla
+    async register(username, password) {
+        const response = await apiRequest('/auth/register', {
+            method: 'POST',
+            body: JSON.stringify({ username: username, password: password })
+        })
+        AuthAPI.setToken(response.data.token)
+        return response
+    },
+    
+    // Giriş yap ve oturumu sakla
+    async login(username, password) {
+        const response = await apiRequest('/auth/login', {
+            method: 'POST',
+            body: JSON.stringify({ username: username, password: password })
+        })
+        AuthAPI.setToken(response.data.token)
+        return response
+    },
+    
+    // Misafir oturumu aç (cihaz token'ı yoksa yeni misafir oluşturulur)
+    async guest() {
+        const deviceToken = localStorage.getItem(DEVICE_TOKEN_KEY)
+        const response = await apiRequest('/auth/guest', {
+            method: 'POST',
+            body: JSON.stringify(deviceToken ? { deviceToken: deviceToken } : {})
+        })
+        if (response.data.deviceToken) {
+            localStorage.setItem(DEVICE_TOKEN_KEY, response.data.deviceToken)
+        }
+        AuthAPI.setToken(response.data.token)
+        return response
+    },
+    
+    // Misafir hesabını kayıtlı hesaba yükselt (kayıtlar taşınır)
+    async upgrade(username, password) {
+        const response = await apiRequest('/auth/upgrade', {
+            method: 'POST',
+            body: JSON.stringify({ username: username, password: password })
+        })
+        localStorage.removeItem(DEVICE_TOKEN_KEY)
+        AuthAPI.setToken(response.data.token)
+        return response
+    },
+    
+    // Oturumu kapat
+    logout() {
+        AuthAPI.setToken(null)
+    },
+    
+    // Saklanan token'ı döndür
+    getToken() {
+        return localStorage.getItem(AUTH_TOKEN_KEY)
+    },
+    
+    // Token'ı sakla (null ise sil)
+    setToken(token) {
+        if (token) {
+            localStorage.setItem(AUTH_TOKEN_KEY, token)
+        } else {
+            localStorage.removeItem(AUTH_TOKEN_KEY)
+        }
+    },
+    
+    // Oturum açık mı
+    isLoggedIn() {
+        return !!AuthAPI.getToken()
+    }
+}
+
+// Oyun durumu API fonksiyonları (oturum gerekli, kullanıcı token'dan belirlenir)
 export const GameStateAPI = {
     // Oyun durumunu kaydet
     async save(userId, gameState) {
@@ -66,12 +156,12 @@ export const GameStateAPI = {
     
     // Oyun durumunu yükle
     async load(userId) {
-        return await apiRequest(`/game-state/${userId}`)
+        return await apiRequest('/game-state')
     },
     
     // Oyun durumunu sil
     async delete(userId) {
-        return await apiRequest(`/game-state/${userId}`, {
+        return await apiRequest('/game-state', {
             method: 'DELETE'
         })
     }
@@ -79,15 +169,16 @@ export const GameStateAPI = {
 
 // Yüksek skor API fonksiyonları
 export const HighscoreAPI = {
-    // Yüksek skor kaydet
-    async save(userId, playerName, score, finalBlind, jokersUsed = [], seed = '') {
+    // Yüksek skor kaydet (sunucu run'ın kayıtlı seed'i + aksiyon kaydını yeniden oynatarak doğrular)
+    async save(userId, playerName, score, finalBlind, jokersUsed = [], runId = '', actions = []) {
         const requestData = {
             userId: userId,
             playerName: playerName,
             score: score,
             finalBlind: finalBlind,
             jokersUsed: jokersUsed,
-            seed: seed
+            runId: runId, // Seed, deste, stake ve kilitler sunucuda bu run'dan alınır
+            actions: actions
         }
         
         return await apiRequest('/highscores', {
@@ -97,11 +188,17 @@ export const HighscoreAPI = {
     },
     
     // Yüksek skorları listele
-    async getList(limit = 10, offset = 0, userId = null) {
+    async getList(limit = 10, offset = 0, userId = null, deck = null, stake = null) {
         let endpoint = `/highscores?limit=${limit}&offset=${offset}`
         if (userId) {
             endpoint += `&userId=${userId}`
         }
+        if (deck) {
+            endpoint += `&deck=${deck}`
+        }
+        if (stake) {
+            endpoint += `&stake=${stake}`
+        }
         
         return await apiRequest(endpoint)
     },
@@ -112,6 +209,84 @@ export const HighscoreAPI = {
     }
 }
 
+// Profil API fonksiyonları
+export const ProfileAPI = {
+    // Keşfedilmiş ve kilitli öğeleri getir
+    async getCollection(userId) {
+        return await apiRequest(`/profile/${userId}/collection`)
+    }
+}
+
+// Run API fonksiyonları (sunucu tarafı oyun akışı)
+export const RunAPI = {
+    // Yeni run başlat (seed boşsa sunucu üretir, deste boşsa red, stake boşsa white)
+    async create(userId, seed = '', deck = '', stake = '') {
+        return await apiRequest('/runs', {
+            method: 'POST',
+            body: JSON.stringify({ userId: userId, seed: seed, deck: deck, stake: stake })
+        })
+    },
+    
+    // Run durumunu yükle
+    async get(runId) {
+        return await apiRequest(`/runs/${runId}`)
+    },
+    
+    // Eldeki kartları indeksleriyle oyna
+    async play(runId, cardIndices) {
+        return await apiRequest(`/runs/${runId}/play`, {
+            method: 'POST',
+            body: JSON.stringify({ cards: cardIndices })
+        })
+    },
+    
+    // Eldeki kartları indeksleriyle at
+    async discard(runId, cardIndices) {
+        return await apiRequest(`/runs/${runId}/discard`, {
+            method: 'POST',
+            body: JSON.stringify({ cards: cardIndices })
+        })
+    },
+    
+    // Dükkandan öğe satın al
+    async buy(runId, slot) {
+        return await apiRequest(`/runs/${runId}/shop/buy`, {
+            method: 'POST',
+            body: JSON.stringify({ slot: slot })
+        })
+    },
+    
+    // Dükkanı yenile (her reroll'da ücret artar)
+    async reroll(runId) {
+        return await apiRequest(`/runs/${runId}/shop/reroll`, {
+            method: 'POST'
+        })
+    },
+    
+    // Satın alınmış paketi aç
+    async openPack(runId, index = 0) {
+        return await apiRequest(`/runs/${runId}/packs/open`, {
+            method: 'POST',
+            body: JSON.stringify({ index: index })
+        })
+    },
+    
+    // Açık paketten seçim yap (boş liste paketi atlar)
+    async pickFromPack(runId, choices) {
+        return await apiRequest(`/runs/${runId}/packs/pick`, {
+            method: 'POST',
+            body: JSON.stringify({ choices: choices })
+        })
+    },
+    
+    // Sonraki faza geç (dükkan → blind seçimi → oyun)
+    async advance(runId) {
+        return await apiRequest(`/runs/${runId}/advance`, {
+            method: 'POST'
+        })
+    }
+}
+
 // Sistem API fonksiyonları
 export const SystemAPI = {
     // Sistem sağlık durumunu kontrol et
@@ -25,7 +25,7 @@ export const VALUES = {
 
 export const ENHANCEMENTS = {
     WILD: 'WILD',                 // Joker - herhangi bir türe dönüşebilir
-    GLASS: 'GLASS',               // Cam - ekstra çarpan, %50 yok olma riski
+    GLASS: 'GLASS',               // Cam - x2 çarpan, 1/4 kırılma riski
     STEEL: 'STEEL',               // Çelik - elde tutulduğunda çarpan
     GOLD: 'GOLD',                 // Altın - oynandığında ekstra para
     STONE: 'STONE',               // Taş - ekstra çip, kullanılamaz
@@ -87,9 +87,6 @@ export class Card {
                 case ENHANCEMENTS.MULTIPLIER_2:
                     bonus += 2
                     break
-                case ENHANCEMENTS.GLASS:
-                    bonus += 2 // Cam kartlar +2 çarpan verir
-                    break
                 case ENHANCEMENTS.STEEL:
                     bonus += 1 // Çelik kartlar +1 çarpan verir (elde tutulduğunda)
                     break
@@ -98,6 +95,19 @@ export class Card {
         return bonus
     }
 
+    // Enhancement'lardan gelen çarpan katsayısını hesapla (backend scoring ile aynı)
+    getEnhancementXMultiplier() {
+        let factor = 1
+        this.enhancements.forEach(enhancement => {
+            switch(enhancement) {
+                case ENHANCEMENTS.GLASS:
+                    factor *= 2 // Cam kartlar çarpanı ikiye katlar
+                    break
+            }
+        })
+        return factor
+    }
+
     // Kartın toplam çip değeri
     getTotalChipValue() {
         return this.getBaseChipValue() + this.getEnhancementChipBonus()
@@ -111,7 +121,7 @@ export class Card {
     // Kartın oynandıktan sonra yok olup olmayacağını kontrol et (Glass effect)
     shouldDestroyAfterPlay() {
         if (this.enhancements.includes(ENHANCEMENTS.GLASS)) {
-            return Math.random() < 0.5 // %50 şans
+            return Math.random() < 0.25 // 1/4 şans (backend cards.GlassShatterOdds)
         }
         return false
     }
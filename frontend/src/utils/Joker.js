@@ -33,6 +33,17 @@ export class Joker {
         this.sellValue = this.getSellValue()
     }
     
+    // Joker'ın dükkan fiyatı (backend jokers.Definition.Price ile aynı)
+    getPrice() {
+        const prices = {
+            common: 5,
+            uncommon: 8,
+            rare: 12,
+            legendary: 20
+        }
+        return prices[this.rarity] || 5
+    }
+    
     // Joker'ın satış değerini hesapla
     getSellValue() {
         const baseValues = {